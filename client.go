@@ -10,6 +10,8 @@ import (
 
 type Client struct {
 	options Options
+
+	clockOffsets clockOffsets
 }
 
 func New(options *Options, optFns ...func(*Options)) (*Client, error) {
@@ -45,8 +47,9 @@ func PerformCall[
 	OutputBase any,
 ](ctx context.Context, c *Client, input Input, optFns ...func(*Options)) (OutputPtr, *Metadata, error) {
 	in := &handlerInput[Input]{
-		Options:   c.options.With(optFns...),
-		CallInput: input,
+		Options:      c.options.With(optFns...),
+		CallInput:    input,
+		ClockOffsets: &c.clockOffsets,
 	}
 
 	chain := chain_of_responsibility.NewChain(
@@ -56,6 +59,7 @@ func PerformCall[
 		&requiredInputMiddleware[Input, OutputPtr]{},
 		&userAgentMiddleware[Input, OutputPtr]{},
 		&resolveEndpointMiddleware[Input, OutputPtr]{},
+		&clockSkewMiddleware[Input, OutputPtr]{},
 		&transportMiddleware[Input, OutputBase, OutputPtr]{},
 		&signerMiddleware[Input, OutputPtr]{},
 	)
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*CompleteMultipartUploadInput)(nil)
+
+type CompleteMultipartUploadInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	// UploadId is mandatory
+	UploadId string
+
+	// IfMatch only completes the upload when the current ETag of the object matches.
+	// A mismatch is reported as [ErrPreconditionFailed], a concurrent
+	// conditional write as [ErrConditionalRequestConflict].
+	IfMatch *string
+
+	// IfNoneMatch set to "*" only completes the upload when the object does not exist yet.
+	// An existing object is reported as [ErrPreconditionFailed], a concurrent
+	// conditional write as [ErrConditionalRequestConflict].
+	IfNoneMatch *string
+
+	ChecksumCRC32        *string
+	ChecksumCRC32C       *string
+	ChecksumCRC64NVME    *string
+	ChecksumSHA1         *string
+	ChecksumSHA256       *string
+	ChecksumType         *string
+	MpObjectSize         *string
+	RequestPayer         *string
+	ExpectedBucketOwner  *string
+	SSECustomerAlgorithm *string
+	SSECustomerKey       *string
+	SSECustomerKeyMD5    *string
+
+	MultipartUpload types.CompletedMultipartUpload
+}
+
+func (input *CompleteMultipartUploadInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *CompleteMultipartUploadInput) GetKey() string {
+	return input.Key
+}
+
+func (input *CompleteMultipartUploadInput) MarshalHTTP(req *fasthttp.Request) error {
+	if input.UploadId == "" {
+		return errors.New("upload id is mandatory")
+	}
+
+	req.Header.SetMethod(fasthttp.MethodPost)
+
+	req.URI().QueryArgs().Set(QueryUploadID, input.UploadId)
+
+	if err := setXMLBody(req, &struct {
+		XMLName xml.Name `xml:"CompleteMultipartUpload"`
+		types.CompletedMultipartUpload
+	}{CompletedMultipartUpload: input.MultipartUpload}); err != nil {
+		return err
+	}
+
+	setHeader(&req.Header, HeaderIfMatch, input.IfMatch)
+	setHeader(&req.Header, HeaderIfNoneMatch, input.IfNoneMatch)
+
+	setHeader(&req.Header, HeaderXAmzChecksumCRC32, input.ChecksumCRC32)
+	setHeader(&req.Header, HeaderXAmzChecksumCRC32C, input.ChecksumCRC32C)
+	setHeader(&req.Header, HeaderXAmzChecksumCRC64NVME, input.ChecksumCRC64NVME)
+	setHeader(&req.Header, HeaderXAmzChecksumSHA1, input.ChecksumSHA1)
+	setHeader(&req.Header, HeaderXAmzChecksumSHA256, input.ChecksumSHA256)
+	setHeader(&req.Header, HeaderXAmzChecksumType, input.ChecksumType)
+	setHeader(&req.Header, HeaderXAmzMpObjectSize, input.MpObjectSize)
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+	setHeader(&req.Header, HeaderXAmzSSECustomerAlgorithm, input.SSECustomerAlgorithm)
+	setHeader(&req.Header, HeaderXAmzSSECustomerKey, input.SSECustomerKey)
+	setHeader(&req.Header, HeaderXAmzSSECustomerKeyMD5, input.SSECustomerKeyMD5)
+
+	return nil
+}
+
+type CompleteMultipartUploadOutput struct {
+	Expiration           *string
+	ServerSideEncryption *string
+	VersionId            *string
+	SSEKMSKeyId          *string
+	BucketKeyEnabled     *string
+	RequestCharged       *string
+
+	Payload *types.CompleteMultipartUploadResult
+}
+
+func (output *CompleteMultipartUploadOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload struct {
+		XMLName xml.Name
+		types.CompleteMultipartUploadResult
+	}
+	if err := unmarshalXMLBody(resp, "CompleteMultipartUpload", &payload); err != nil {
+		return err
+	}
+
+	// The failure may only be known after the server has answered 200 OK
+	if payload.XMLName.Local == "Error" {
+		return NewServerSideError(resp)
+	}
+
+	output.Expiration = extractHeader(&resp.Header, HeaderXAmzExpiration)
+	output.ServerSideEncryption = extractHeader(&resp.Header, HeaderXAmzServerSideEncryption)
+	output.VersionId = extractHeader(&resp.Header, HeaderXAmzVersionId)
+	output.SSEKMSKeyId = extractHeader(&resp.Header, HeaderXAmzSSEKMSKeyId)
+	output.BucketKeyEnabled = extractHeader(&resp.Header, HeaderXAmzBucketKeyEnabled)
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	output.Payload = &payload.CompleteMultipartUploadResult
+	return nil
+}
+
+func (c *Client) CompleteMultipartUpload(ctx context.Context, input *CompleteMultipartUploadInput, optFns ...func(*Options)) (*CompleteMultipartUploadOutput, *Metadata, error) {
+	return PerformCall[*CompleteMultipartUploadInput, *CompleteMultipartUploadOutput](ctx, c, input, optFns...)
+}
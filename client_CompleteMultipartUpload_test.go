@@ -0,0 +1,104 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/fasthttptesting"
+	"github.com/s3hobby/client/pkg/signer"
+	"github.com/s3hobby/client/pkg/utils"
+	"github.com/s3hobby/client/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func testCompleteMultipartUpload(t *testing.T, handler fasthttp.RequestHandler) (*CompleteMultipartUploadOutput, error) {
+	srv := fasthttptesting.NewInmemoryTester(handler)
+	defer srv.Close()
+
+	c, err := New(&Options{
+		SiginingRegion: "dev-1",
+		EndpointHost:   "s3.dev-1.example.com",
+		Signer:         signer.NewAnonymousSigner(),
+		HTTPClient:     srv.Client(),
+	})
+	require.NoError(t, err)
+
+	out, _, err := c.CompleteMultipartUpload(t.Context(), &CompleteMultipartUploadInput{
+		Bucket:      "bucket",
+		Key:         "key",
+		UploadId:    "upload-id",
+		IfNoneMatch: utils.ToPtr("*"),
+		MultipartUpload: types.CompletedMultipartUpload{
+			Parts: []types.CompletedPart{
+				{ETag: utils.ToPtr(`"etag-1"`), PartNumber: utils.ToPtr("1")},
+				{ETag: utils.ToPtr(`"etag-2"`), PartNumber: utils.ToPtr("2")},
+			},
+		},
+	})
+
+	return out, err
+}
+
+func TestCompleteMultipartUpload(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		out, err := testCompleteMultipartUpload(t, func(ctx *fasthttp.RequestCtx) {
+			assert.Equal(t, "http://bucket.s3.dev-1.example.com/key?uploadId=upload-id", ctx.URI().String())
+			assert.Equal(t, fasthttp.MethodPost, string(ctx.Method()))
+			assert.Equal(t, "*", string(ctx.Request.Header.Peek(HeaderIfNoneMatch)))
+			assert.Empty(t, ctx.Request.Header.Peek(HeaderIfMatch))
+			assert.Equal(t,
+				`<CompleteMultipartUpload>`+
+					`<Part><ETag>&#34;etag-1&#34;</ETag><PartNumber>1</PartNumber></Part>`+
+					`<Part><ETag>&#34;etag-2&#34;</ETag><PartNumber>2</PartNumber></Part>`+
+					`</CompleteMultipartUpload>`,
+				string(ctx.Request.Body()))
+
+			ctx.Response.Header.Set(HeaderXAmzVersionId, "version")
+			ctx.Response.SetBodyString(`<?xml version="1.0" encoding="UTF-8"?>
+<CompleteMultipartUploadResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<Location>http://bucket.s3.dev-1.example.com/key</Location>
+	<Bucket>bucket</Bucket>
+	<Key>key</Key>
+	<ETag>"etag-2"</ETag>
+</CompleteMultipartUploadResult>`)
+		})
+		require.NoError(t, err)
+		require.Equal(t, utils.ToPtr("version"), out.VersionId)
+		require.Equal(t, &types.CompleteMultipartUploadResult{
+			Location: utils.ToPtr("http://bucket.s3.dev-1.example.com/key"),
+			Bucket:   utils.ToPtr("bucket"),
+			Key:      utils.ToPtr("key"),
+			ETag:     utils.ToPtr(`"etag-2"`),
+		}, out.Payload)
+	})
+
+	t.Run("conditional conflict", func(t *testing.T) {
+		_, err := testCompleteMultipartUpload(t, func(ctx *fasthttp.RequestCtx) {
+			ctx.SetStatusCode(fasthttp.StatusConflict)
+			ctx.Response.SetBodyString(`<Error><Code>ConditionalRequestConflict</Code></Error>`)
+		})
+		require.ErrorIs(t, err, ErrConditionalRequestConflict)
+		require.NotErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("precondition failed", func(t *testing.T) {
+		_, err := testCompleteMultipartUpload(t, func(ctx *fasthttp.RequestCtx) {
+			ctx.SetStatusCode(fasthttp.StatusPreconditionFailed)
+			ctx.Response.SetBodyString(`<Error><Code>PreconditionFailed</Code></Error>`)
+		})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("error after 200 OK", func(t *testing.T) {
+		_, err := testCompleteMultipartUpload(t, func(ctx *fasthttp.RequestCtx) {
+			ctx.Response.SetBodyString(`<Error><Code>InternalError</Code></Error>`)
+		})
+
+		var serverErr *ServerSideError
+		require.True(t, errors.As(err, &serverErr))
+		require.Equal(t, "InternalError", serverErr.Code)
+	})
+}
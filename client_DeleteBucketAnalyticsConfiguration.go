@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketAnalyticsConfigurationInput)(nil)
+var _ RequiredIDInterface = (*DeleteBucketAnalyticsConfigurationInput)(nil)
+
+type DeleteBucketAnalyticsConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketAnalyticsConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketAnalyticsConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *DeleteBucketAnalyticsConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryAnalytics)
+	args.Set(QueryID, input.Id)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketAnalyticsConfigurationOutput struct{}
+
+func (*DeleteBucketAnalyticsConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketAnalyticsConfiguration(ctx context.Context, input *DeleteBucketAnalyticsConfigurationInput, optFns ...func(*Options)) (*DeleteBucketAnalyticsConfigurationOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketAnalyticsConfigurationInput, *DeleteBucketAnalyticsConfigurationOutput](ctx, c, input, optFns...)
+}
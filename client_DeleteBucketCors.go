@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketCorsInput)(nil)
+
+type DeleteBucketCorsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketCorsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketCorsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	req.URI().QueryArgs().SetNoValue(QueryCors)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketCorsOutput struct{}
+
+func (*DeleteBucketCorsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketCors(ctx context.Context, input *DeleteBucketCorsInput, optFns ...func(*Options)) (*DeleteBucketCorsOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketCorsInput, *DeleteBucketCorsOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketEncryptionInput)(nil)
+
+type DeleteBucketEncryptionInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketEncryptionInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketEncryptionInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	req.URI().QueryArgs().SetNoValue(QueryEncryption)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketEncryptionOutput struct{}
+
+func (*DeleteBucketEncryptionOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketEncryption(ctx context.Context, input *DeleteBucketEncryptionInput, optFns ...func(*Options)) (*DeleteBucketEncryptionOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketEncryptionInput, *DeleteBucketEncryptionOutput](ctx, c, input, optFns...)
+}
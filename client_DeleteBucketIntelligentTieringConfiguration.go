@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketIntelligentTieringConfigurationInput)(nil)
+var _ RequiredIDInterface = (*DeleteBucketIntelligentTieringConfigurationInput)(nil)
+
+type DeleteBucketIntelligentTieringConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketIntelligentTieringConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketIntelligentTieringConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *DeleteBucketIntelligentTieringConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryIntelligentTiering)
+	args.Set(QueryID, input.Id)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketIntelligentTieringConfigurationOutput struct{}
+
+func (*DeleteBucketIntelligentTieringConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketIntelligentTieringConfiguration(ctx context.Context, input *DeleteBucketIntelligentTieringConfigurationInput, optFns ...func(*Options)) (*DeleteBucketIntelligentTieringConfigurationOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketIntelligentTieringConfigurationInput, *DeleteBucketIntelligentTieringConfigurationOutput](ctx, c, input, optFns...)
+}
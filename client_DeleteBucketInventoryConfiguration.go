@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketInventoryConfigurationInput)(nil)
+var _ RequiredIDInterface = (*DeleteBucketInventoryConfigurationInput)(nil)
+
+type DeleteBucketInventoryConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketInventoryConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketInventoryConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *DeleteBucketInventoryConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryInventory)
+	args.Set(QueryID, input.Id)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketInventoryConfigurationOutput struct{}
+
+func (*DeleteBucketInventoryConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketInventoryConfiguration(ctx context.Context, input *DeleteBucketInventoryConfigurationInput, optFns ...func(*Options)) (*DeleteBucketInventoryConfigurationOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketInventoryConfigurationInput, *DeleteBucketInventoryConfigurationOutput](ctx, c, input, optFns...)
+}
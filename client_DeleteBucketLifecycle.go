@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketLifecycleInput)(nil)
+
+type DeleteBucketLifecycleInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketLifecycleInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketLifecycleInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	req.URI().QueryArgs().SetNoValue(QueryLifecycle)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketLifecycleOutput struct{}
+
+func (*DeleteBucketLifecycleOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketLifecycle(ctx context.Context, input *DeleteBucketLifecycleInput, optFns ...func(*Options)) (*DeleteBucketLifecycleOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketLifecycleInput, *DeleteBucketLifecycleOutput](ctx, c, input, optFns...)
+}
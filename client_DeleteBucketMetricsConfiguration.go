@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketMetricsConfigurationInput)(nil)
+var _ RequiredIDInterface = (*DeleteBucketMetricsConfigurationInput)(nil)
+
+type DeleteBucketMetricsConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketMetricsConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketMetricsConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *DeleteBucketMetricsConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryMetrics)
+	args.Set(QueryID, input.Id)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketMetricsConfigurationOutput struct{}
+
+func (*DeleteBucketMetricsConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketMetricsConfiguration(ctx context.Context, input *DeleteBucketMetricsConfigurationInput, optFns ...func(*Options)) (*DeleteBucketMetricsConfigurationOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketMetricsConfigurationInput, *DeleteBucketMetricsConfigurationOutput](ctx, c, input, optFns...)
+}
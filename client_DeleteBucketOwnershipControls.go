@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketOwnershipControlsInput)(nil)
+
+type DeleteBucketOwnershipControlsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketOwnershipControlsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketOwnershipControlsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	req.URI().QueryArgs().SetNoValue(QueryOwnershipControls)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketOwnershipControlsOutput struct{}
+
+func (*DeleteBucketOwnershipControlsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketOwnershipControls(ctx context.Context, input *DeleteBucketOwnershipControlsInput, optFns ...func(*Options)) (*DeleteBucketOwnershipControlsOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketOwnershipControlsInput, *DeleteBucketOwnershipControlsOutput](ctx, c, input, optFns...)
+}
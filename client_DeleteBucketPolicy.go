@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketPolicyInput)(nil)
+
+type DeleteBucketPolicyInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketPolicyInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketPolicyInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	req.URI().QueryArgs().SetNoValue(QueryPolicy)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketPolicyOutput struct{}
+
+func (*DeleteBucketPolicyOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketPolicy(ctx context.Context, input *DeleteBucketPolicyInput, optFns ...func(*Options)) (*DeleteBucketPolicyOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketPolicyInput, *DeleteBucketPolicyOutput](ctx, c, input, optFns...)
+}
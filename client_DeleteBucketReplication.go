@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketReplicationInput)(nil)
+
+type DeleteBucketReplicationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketReplicationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketReplicationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	req.URI().QueryArgs().SetNoValue(QueryReplication)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketReplicationOutput struct{}
+
+func (*DeleteBucketReplicationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketReplication(ctx context.Context, input *DeleteBucketReplicationInput, optFns ...func(*Options)) (*DeleteBucketReplicationOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketReplicationInput, *DeleteBucketReplicationOutput](ctx, c, input, optFns...)
+}
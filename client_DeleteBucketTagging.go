@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketTaggingInput)(nil)
+
+type DeleteBucketTaggingInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketTaggingInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketTaggingInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	req.URI().QueryArgs().SetNoValue(QueryTagging)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketTaggingOutput struct{}
+
+func (*DeleteBucketTaggingOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketTagging(ctx context.Context, input *DeleteBucketTaggingInput, optFns ...func(*Options)) (*DeleteBucketTaggingOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketTaggingInput, *DeleteBucketTaggingOutput](ctx, c, input, optFns...)
+}
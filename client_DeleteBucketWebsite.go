@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeleteBucketWebsiteInput)(nil)
+
+type DeleteBucketWebsiteInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeleteBucketWebsiteInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeleteBucketWebsiteInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	req.URI().QueryArgs().SetNoValue(QueryWebsite)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeleteBucketWebsiteOutput struct{}
+
+func (*DeleteBucketWebsiteOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteBucketWebsite(ctx context.Context, input *DeleteBucketWebsiteInput, optFns ...func(*Options)) (*DeleteBucketWebsiteOutput, *Metadata, error) {
+	return PerformCall[*DeleteBucketWebsiteInput, *DeleteBucketWebsiteOutput](ctx, c, input, optFns...)
+}
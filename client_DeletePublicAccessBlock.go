@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*DeletePublicAccessBlockInput)(nil)
+
+type DeletePublicAccessBlockInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *DeletePublicAccessBlockInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *DeletePublicAccessBlockInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodDelete)
+
+	req.URI().QueryArgs().SetNoValue(QueryPublicAccessBlock)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type DeletePublicAccessBlockOutput struct{}
+
+func (*DeletePublicAccessBlockOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) DeletePublicAccessBlock(ctx context.Context, input *DeletePublicAccessBlockInput, optFns ...func(*Options)) (*DeletePublicAccessBlockOutput, *Metadata, error) {
+	return PerformCall[*DeletePublicAccessBlockInput, *DeletePublicAccessBlockOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketAclInput)(nil)
+
+type GetBucketAclInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketAclInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketAclInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryACL)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketAclOutput struct {
+	Payload *types.AccessControlPolicy
+}
+
+func (output *GetBucketAclOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.AccessControlPolicy
+	if err := unmarshalXMLBody(resp, "GetBucketAcl", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketAcl(ctx context.Context, input *GetBucketAclInput, optFns ...func(*Options)) (*GetBucketAclOutput, *Metadata, error) {
+	return PerformCall[*GetBucketAclInput, *GetBucketAclOutput](ctx, c, input, optFns...)
+}
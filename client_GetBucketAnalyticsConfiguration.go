@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketAnalyticsConfigurationInput)(nil)
+var _ RequiredIDInterface = (*GetBucketAnalyticsConfigurationInput)(nil)
+
+type GetBucketAnalyticsConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketAnalyticsConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketAnalyticsConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *GetBucketAnalyticsConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryAnalytics)
+	args.Set(QueryID, input.Id)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketAnalyticsConfigurationOutput struct {
+	Payload *types.AnalyticsConfiguration
+}
+
+func (output *GetBucketAnalyticsConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.AnalyticsConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketAnalyticsConfiguration", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketAnalyticsConfiguration(ctx context.Context, input *GetBucketAnalyticsConfigurationInput, optFns ...func(*Options)) (*GetBucketAnalyticsConfigurationOutput, *Metadata, error) {
+	return PerformCall[*GetBucketAnalyticsConfigurationInput, *GetBucketAnalyticsConfigurationOutput](ctx, c, input, optFns...)
+}
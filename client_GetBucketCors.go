@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketCorsInput)(nil)
+
+type GetBucketCorsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketCorsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketCorsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryCors)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketCorsOutput struct {
+	Payload *types.CORSConfiguration
+}
+
+func (output *GetBucketCorsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.CORSConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketCors", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketCors(ctx context.Context, input *GetBucketCorsInput, optFns ...func(*Options)) (*GetBucketCorsOutput, *Metadata, error) {
+	return PerformCall[*GetBucketCorsInput, *GetBucketCorsOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketEncryptionInput)(nil)
+
+type GetBucketEncryptionInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketEncryptionInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketEncryptionInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryEncryption)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketEncryptionOutput struct {
+	Payload *types.ServerSideEncryptionConfiguration
+}
+
+func (output *GetBucketEncryptionOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.ServerSideEncryptionConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketEncryption", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketEncryption(ctx context.Context, input *GetBucketEncryptionInput, optFns ...func(*Options)) (*GetBucketEncryptionOutput, *Metadata, error) {
+	return PerformCall[*GetBucketEncryptionInput, *GetBucketEncryptionOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketIntelligentTieringConfigurationInput)(nil)
+var _ RequiredIDInterface = (*GetBucketIntelligentTieringConfigurationInput)(nil)
+
+type GetBucketIntelligentTieringConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketIntelligentTieringConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketIntelligentTieringConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *GetBucketIntelligentTieringConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryIntelligentTiering)
+	args.Set(QueryID, input.Id)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketIntelligentTieringConfigurationOutput struct {
+	Payload *types.IntelligentTieringConfiguration
+}
+
+func (output *GetBucketIntelligentTieringConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.IntelligentTieringConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketIntelligentTieringConfiguration", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketIntelligentTieringConfiguration(ctx context.Context, input *GetBucketIntelligentTieringConfigurationInput, optFns ...func(*Options)) (*GetBucketIntelligentTieringConfigurationOutput, *Metadata, error) {
+	return PerformCall[*GetBucketIntelligentTieringConfigurationInput, *GetBucketIntelligentTieringConfigurationOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketInventoryConfigurationInput)(nil)
+var _ RequiredIDInterface = (*GetBucketInventoryConfigurationInput)(nil)
+
+type GetBucketInventoryConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketInventoryConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketInventoryConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *GetBucketInventoryConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryInventory)
+	args.Set(QueryID, input.Id)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketInventoryConfigurationOutput struct {
+	Payload *types.InventoryConfiguration
+}
+
+func (output *GetBucketInventoryConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.InventoryConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketInventoryConfiguration", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketInventoryConfiguration(ctx context.Context, input *GetBucketInventoryConfigurationInput, optFns ...func(*Options)) (*GetBucketInventoryConfigurationOutput, *Metadata, error) {
+	return PerformCall[*GetBucketInventoryConfigurationInput, *GetBucketInventoryConfigurationOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketLifecycleConfigurationInput)(nil)
+
+type GetBucketLifecycleConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketLifecycleConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketLifecycleConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryLifecycle)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketLifecycleConfigurationOutput struct {
+	TransitionDefaultMinimumObjectSize *string
+
+	Payload *types.LifecycleConfiguration
+}
+
+func (output *GetBucketLifecycleConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.TransitionDefaultMinimumObjectSize = extractHeader(&resp.Header, HeaderXAmzTransitionDefaultMinimumObjectSize)
+
+	var payload types.LifecycleConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketLifecycleConfiguration", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketLifecycleConfiguration(ctx context.Context, input *GetBucketLifecycleConfigurationInput, optFns ...func(*Options)) (*GetBucketLifecycleConfigurationOutput, *Metadata, error) {
+	return PerformCall[*GetBucketLifecycleConfigurationInput, *GetBucketLifecycleConfigurationOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketLoggingInput)(nil)
+
+type GetBucketLoggingInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketLoggingInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketLoggingInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryLogging)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketLoggingOutput struct {
+	Payload *types.BucketLoggingStatus
+}
+
+func (output *GetBucketLoggingOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.BucketLoggingStatus
+	if err := unmarshalXMLBody(resp, "GetBucketLogging", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketLogging(ctx context.Context, input *GetBucketLoggingInput, optFns ...func(*Options)) (*GetBucketLoggingOutput, *Metadata, error) {
+	return PerformCall[*GetBucketLoggingInput, *GetBucketLoggingOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketMetricsConfigurationInput)(nil)
+var _ RequiredIDInterface = (*GetBucketMetricsConfigurationInput)(nil)
+
+type GetBucketMetricsConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketMetricsConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketMetricsConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *GetBucketMetricsConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryMetrics)
+	args.Set(QueryID, input.Id)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketMetricsConfigurationOutput struct {
+	Payload *types.MetricsConfiguration
+}
+
+func (output *GetBucketMetricsConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.MetricsConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketMetricsConfiguration", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketMetricsConfiguration(ctx context.Context, input *GetBucketMetricsConfigurationInput, optFns ...func(*Options)) (*GetBucketMetricsConfigurationOutput, *Metadata, error) {
+	return PerformCall[*GetBucketMetricsConfigurationInput, *GetBucketMetricsConfigurationOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketNotificationConfigurationInput)(nil)
+
+type GetBucketNotificationConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketNotificationConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketNotificationConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryNotification)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketNotificationConfigurationOutput struct {
+	Payload *types.NotificationConfiguration
+}
+
+func (output *GetBucketNotificationConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.NotificationConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketNotificationConfiguration", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketNotificationConfiguration(ctx context.Context, input *GetBucketNotificationConfigurationInput, optFns ...func(*Options)) (*GetBucketNotificationConfigurationOutput, *Metadata, error) {
+	return PerformCall[*GetBucketNotificationConfigurationInput, *GetBucketNotificationConfigurationOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketOwnershipControlsInput)(nil)
+
+type GetBucketOwnershipControlsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketOwnershipControlsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketOwnershipControlsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryOwnershipControls)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketOwnershipControlsOutput struct {
+	Payload *types.OwnershipControls
+}
+
+func (output *GetBucketOwnershipControlsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.OwnershipControls
+	if err := unmarshalXMLBody(resp, "GetBucketOwnershipControls", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketOwnershipControls(ctx context.Context, input *GetBucketOwnershipControlsInput, optFns ...func(*Options)) (*GetBucketOwnershipControlsOutput, *Metadata, error) {
+	return PerformCall[*GetBucketOwnershipControlsInput, *GetBucketOwnershipControlsOutput](ctx, c, input, optFns...)
+}
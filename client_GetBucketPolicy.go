@@ -0,0 +1,51 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketPolicyInput)(nil)
+
+type GetBucketPolicyInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketPolicyInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketPolicyInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryPolicy)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketPolicyOutput struct {
+	// Policy is the raw JSON policy document as returned by the server.
+	Policy json.RawMessage
+}
+
+func (output *GetBucketPolicyOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.Policy = bytes.Clone(resp.Body())
+
+	return nil
+}
+
+func (c *Client) GetBucketPolicy(ctx context.Context, input *GetBucketPolicyInput, optFns ...func(*Options)) (*GetBucketPolicyOutput, *Metadata, error) {
+	return PerformCall[*GetBucketPolicyInput, *GetBucketPolicyOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketPolicyStatusInput)(nil)
+
+type GetBucketPolicyStatusInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketPolicyStatusInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketPolicyStatusInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryPolicyStatus)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketPolicyStatusOutput struct {
+	Payload *types.PolicyStatus
+}
+
+func (output *GetBucketPolicyStatusOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.PolicyStatus
+	if err := unmarshalXMLBody(resp, "GetBucketPolicyStatus", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketPolicyStatus(ctx context.Context, input *GetBucketPolicyStatusInput, optFns ...func(*Options)) (*GetBucketPolicyStatusOutput, *Metadata, error) {
+	return PerformCall[*GetBucketPolicyStatusInput, *GetBucketPolicyStatusOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketReplicationInput)(nil)
+
+type GetBucketReplicationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketReplicationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketReplicationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryReplication)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketReplicationOutput struct {
+	Payload *types.ReplicationConfiguration
+}
+
+func (output *GetBucketReplicationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.ReplicationConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketReplication", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketReplication(ctx context.Context, input *GetBucketReplicationInput, optFns ...func(*Options)) (*GetBucketReplicationOutput, *Metadata, error) {
+	return PerformCall[*GetBucketReplicationInput, *GetBucketReplicationOutput](ctx, c, input, optFns...)
+}
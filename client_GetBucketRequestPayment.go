@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketRequestPaymentInput)(nil)
+
+type GetBucketRequestPaymentInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketRequestPaymentInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketRequestPaymentInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryRequestPayment)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketRequestPaymentOutput struct {
+	Payload *types.RequestPaymentConfiguration
+}
+
+func (output *GetBucketRequestPaymentOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.RequestPaymentConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketRequestPayment", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketRequestPayment(ctx context.Context, input *GetBucketRequestPaymentInput, optFns ...func(*Options)) (*GetBucketRequestPaymentOutput, *Metadata, error) {
+	return PerformCall[*GetBucketRequestPaymentInput, *GetBucketRequestPaymentOutput](ctx, c, input, optFns...)
+}
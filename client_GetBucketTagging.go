@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketTaggingInput)(nil)
+
+type GetBucketTaggingInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketTaggingInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketTaggingInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryTagging)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketTaggingOutput struct {
+	Payload *types.Tagging
+}
+
+func (output *GetBucketTaggingOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.Tagging
+	if err := unmarshalXMLBody(resp, "GetBucketTagging", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketTagging(ctx context.Context, input *GetBucketTaggingInput, optFns ...func(*Options)) (*GetBucketTaggingOutput, *Metadata, error) {
+	return PerformCall[*GetBucketTaggingInput, *GetBucketTaggingOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketVersioningInput)(nil)
+
+type GetBucketVersioningInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketVersioningInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketVersioningInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryVersioning)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketVersioningOutput struct {
+	Payload *types.VersioningConfiguration
+}
+
+func (output *GetBucketVersioningOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.VersioningConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketVersioning", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketVersioning(ctx context.Context, input *GetBucketVersioningInput, optFns ...func(*Options)) (*GetBucketVersioningOutput, *Metadata, error) {
+	return PerformCall[*GetBucketVersioningInput, *GetBucketVersioningOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetBucketWebsiteInput)(nil)
+
+type GetBucketWebsiteInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetBucketWebsiteInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetBucketWebsiteInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryWebsite)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetBucketWebsiteOutput struct {
+	Payload *types.WebsiteConfiguration
+}
+
+func (output *GetBucketWebsiteOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.WebsiteConfiguration
+	if err := unmarshalXMLBody(resp, "GetBucketWebsite", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetBucketWebsite(ctx context.Context, input *GetBucketWebsiteInput, optFns ...func(*Options)) (*GetBucketWebsiteOutput, *Metadata, error) {
+	return PerformCall[*GetBucketWebsiteInput, *GetBucketWebsiteOutput](ctx, c, input, optFns...)
+}
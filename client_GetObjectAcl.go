@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*GetObjectAclInput)(nil)
+
+type GetObjectAclInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	VersionId *string
+
+	RequestPayer        *string
+	ExpectedBucketOwner *string
+}
+
+func (input *GetObjectAclInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetObjectAclInput) GetKey() string {
+	return input.Key
+}
+
+func (input *GetObjectAclInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryACL)
+	setQuery(args, QueryVersionID, input.VersionId)
+
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetObjectAclOutput struct {
+	RequestCharged *string
+
+	Payload *types.AccessControlPolicy
+}
+
+func (output *GetObjectAclOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	var payload types.AccessControlPolicy
+	if err := unmarshalXMLBody(resp, "GetObjectAcl", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetObjectAcl(ctx context.Context, input *GetObjectAclInput, optFns ...func(*Options)) (*GetObjectAclOutput, *Metadata, error) {
+	return PerformCall[*GetObjectAclInput, *GetObjectAclOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*GetObjectAttributesInput)(nil)
+
+type GetObjectAttributesInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	// ObjectAttributes is mandatory
+	ObjectAttributes []types.ObjectAttribute
+
+	VersionId *string
+
+	// MaxParts and PartNumberMarker paginate the ObjectParts attribute.
+	MaxParts         *string
+	PartNumberMarker *string
+
+	SSECustomerAlgorithm *string
+	SSECustomerKey       *string
+	SSECustomerKeyMD5    *string
+	RequestPayer         *string
+	ExpectedBucketOwner  *string
+}
+
+func (input *GetObjectAttributesInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetObjectAttributesInput) GetKey() string {
+	return input.Key
+}
+
+func (input *GetObjectAttributesInput) MarshalHTTP(req *fasthttp.Request) error {
+	if len(input.ObjectAttributes) == 0 {
+		return errors.New("object attributes are mandatory")
+	}
+
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryAttributes)
+	setQuery(args, QueryVersionID, input.VersionId)
+
+	attributes := make([]string, len(input.ObjectAttributes))
+	for i, attribute := range input.ObjectAttributes {
+		attributes[i] = string(attribute)
+	}
+	req.Header.Set(HeaderXAmzObjectAttributes, strings.Join(attributes, ","))
+
+	setHeader(&req.Header, HeaderXAmzMaxParts, input.MaxParts)
+	setHeader(&req.Header, HeaderXAmzPartNumberMarker, input.PartNumberMarker)
+	setHeader(&req.Header, HeaderXAmzSSECustomerAlgorithm, input.SSECustomerAlgorithm)
+	setHeader(&req.Header, HeaderXAmzSSECustomerKey, input.SSECustomerKey)
+	setHeader(&req.Header, HeaderXAmzSSECustomerKeyMD5, input.SSECustomerKeyMD5)
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetObjectAttributesOutput struct {
+	DeleteMarker   *string
+	LastModified   *string
+	VersionId      *string
+	RequestCharged *string
+
+	Payload *types.GetObjectAttributesResponse
+}
+
+func (output *GetObjectAttributesOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.DeleteMarker = extractHeader(&resp.Header, HeaderXAmzDeleteMarker)
+	output.LastModified = extractHeader(&resp.Header, HeaderLastModified)
+	output.VersionId = extractHeader(&resp.Header, HeaderXAmzVersionId)
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	var payload types.GetObjectAttributesResponse
+	if err := unmarshalXMLBody(resp, "GetObjectAttributes", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetObjectAttributes(ctx context.Context, input *GetObjectAttributesInput, optFns ...func(*Options)) (*GetObjectAttributesOutput, *Metadata, error) {
+	return PerformCall[*GetObjectAttributesInput, *GetObjectAttributesOutput](ctx, c, input, optFns...)
+}
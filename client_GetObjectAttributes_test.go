@@ -0,0 +1,107 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+	"github.com/s3hobby/client/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestGetObjectAttributes(t *testing.T) {
+	c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		assert.Equal(t, "http://bucket.s3.dev-1.example.com/key?attributes&versionId=v1", ctx.URI().String())
+		assert.Equal(t, fasthttp.MethodGet, string(ctx.Method()))
+		assert.Equal(t, "ETag,Checksum,ObjectParts,StorageClass,ObjectSize", string(ctx.Request.Header.Peek(HeaderXAmzObjectAttributes)))
+		assert.Equal(t, "2", string(ctx.Request.Header.Peek(HeaderXAmzMaxParts)))
+		assert.Equal(t, "1", string(ctx.Request.Header.Peek(HeaderXAmzPartNumberMarker)))
+
+		ctx.Response.Header.Set(HeaderLastModified, "Mon, 19 Oct 2026 12:00:00 GMT")
+		ctx.Response.Header.Set(HeaderXAmzVersionId, "v1")
+		ctx.Response.SetBodyString(`<?xml version="1.0" encoding="UTF-8"?>
+<GetObjectAttributesResponse xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<ETag>8e4d53e4a8e8c5c26e0d4e2a0f2b1c3d-3</ETag>
+	<Checksum>
+		<ChecksumSHA256>7GhdBgCy4S8sgr+LmaG6p3D0Ps+E3y/B+2/n1Qd56LM=</ChecksumSHA256>
+		<ChecksumType>COMPOSITE</ChecksumType>
+	</Checksum>
+	<ObjectParts>
+		<PartsCount>3</PartsCount>
+		<PartNumberMarker>1</PartNumberMarker>
+		<NextPartNumberMarker>3</NextPartNumberMarker>
+		<MaxParts>2</MaxParts>
+		<IsTruncated>true</IsTruncated>
+		<Part>
+			<PartNumber>2</PartNumber>
+			<Size>5242880</Size>
+			<ChecksumSHA256>mmDrPjq1pUeBJFdIXdsfHUGJkFhaFbNkb5Ik1cxbHRA=</ChecksumSHA256>
+		</Part>
+		<Part>
+			<PartNumber>3</PartNumber>
+			<Size>1048576</Size>
+			<ChecksumSHA256>fgSCbA3GbMzy+MMo9IfwB0Wr+o2bSe6WH4GBkDqPyhI=</ChecksumSHA256>
+		</Part>
+	</ObjectParts>
+	<StorageClass>STANDARD_IA</StorageClass>
+	<ObjectSize>11534336</ObjectSize>
+</GetObjectAttributesResponse>`)
+	})
+
+	out, _, err := c.GetObjectAttributes(t.Context(), &GetObjectAttributesInput{
+		Bucket:    "bucket",
+		Key:       "key",
+		VersionId: utils.ToPtr("v1"),
+		ObjectAttributes: []types.ObjectAttribute{
+			types.ObjectAttributeETag,
+			types.ObjectAttributeChecksum,
+			types.ObjectAttributeObjectParts,
+			types.ObjectAttributeStorageClass,
+			types.ObjectAttributeObjectSize,
+		},
+		MaxParts:         utils.ToPtr("2"),
+		PartNumberMarker: utils.ToPtr("1"),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "Mon, 19 Oct 2026 12:00:00 GMT", *out.LastModified)
+	require.Equal(t, "v1", *out.VersionId)
+	require.Nil(t, out.DeleteMarker)
+
+	require.Equal(t, &types.GetObjectAttributesResponse{
+		ETag: utils.ToPtr("8e4d53e4a8e8c5c26e0d4e2a0f2b1c3d-3"),
+		Checksum: &types.Checksum{
+			ChecksumSHA256: utils.ToPtr("7GhdBgCy4S8sgr+LmaG6p3D0Ps+E3y/B+2/n1Qd56LM="),
+			ChecksumType:   utils.ToPtr("COMPOSITE"),
+		},
+		ObjectParts: &types.GetObjectAttributesParts{
+			TotalPartsCount:      utils.ToPtr("3"),
+			PartNumberMarker:     utils.ToPtr("1"),
+			NextPartNumberMarker: utils.ToPtr("3"),
+			MaxParts:             utils.ToPtr("2"),
+			IsTruncated:          utils.ToPtr("true"),
+			Parts: []types.ObjectPart{
+				{
+					PartNumber:     utils.ToPtr("2"),
+					Size:           utils.ToPtr("5242880"),
+					ChecksumSHA256: utils.ToPtr("mmDrPjq1pUeBJFdIXdsfHUGJkFhaFbNkb5Ik1cxbHRA="),
+				},
+				{
+					PartNumber:     utils.ToPtr("3"),
+					Size:           utils.ToPtr("1048576"),
+					ChecksumSHA256: utils.ToPtr("fgSCbA3GbMzy+MMo9IfwB0Wr+o2bSe6WH4GBkDqPyhI="),
+				},
+			},
+		},
+		StorageClass: utils.ToPtr("STANDARD_IA"),
+		ObjectSize:   utils.ToPtr("11534336"),
+	}, out.Payload)
+}
+
+func TestGetObjectAttributesInput_MarshalHTTP_missingAttributes(t *testing.T) {
+	input := &GetObjectAttributesInput{Bucket: "bucket", Key: "key"}
+	require.Equal(t, errors.New("object attributes are mandatory"), input.MarshalHTTP(&fasthttp.Request{}))
+}
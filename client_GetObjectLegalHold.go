@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*GetObjectLegalHoldInput)(nil)
+
+type GetObjectLegalHoldInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	VersionId *string
+
+	RequestPayer        *string
+	ExpectedBucketOwner *string
+}
+
+func (input *GetObjectLegalHoldInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetObjectLegalHoldInput) GetKey() string {
+	return input.Key
+}
+
+func (input *GetObjectLegalHoldInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryLegalHold)
+	setQuery(args, QueryVersionID, input.VersionId)
+
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetObjectLegalHoldOutput struct {
+	RequestCharged *string
+
+	Payload *types.ObjectLockLegalHold
+}
+
+func (output *GetObjectLegalHoldOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	var payload types.ObjectLockLegalHold
+	if err := unmarshalXMLBody(resp, "GetObjectLegalHold", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetObjectLegalHold(ctx context.Context, input *GetObjectLegalHoldInput, optFns ...func(*Options)) (*GetObjectLegalHoldOutput, *Metadata, error) {
+	return PerformCall[*GetObjectLegalHoldInput, *GetObjectLegalHoldOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetObjectLockConfigurationInput)(nil)
+
+type GetObjectLockConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetObjectLockConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetObjectLockConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryObjectLock)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetObjectLockConfigurationOutput struct {
+	Payload *types.ObjectLockConfiguration
+}
+
+func (output *GetObjectLockConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.ObjectLockConfiguration
+	if err := unmarshalXMLBody(resp, "GetObjectLockConfiguration", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetObjectLockConfiguration(ctx context.Context, input *GetObjectLockConfigurationInput, optFns ...func(*Options)) (*GetObjectLockConfigurationOutput, *Metadata, error) {
+	return PerformCall[*GetObjectLockConfigurationInput, *GetObjectLockConfigurationOutput](ctx, c, input, optFns...)
+}
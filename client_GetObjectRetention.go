@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*GetObjectRetentionInput)(nil)
+
+type GetObjectRetentionInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	VersionId *string
+
+	RequestPayer        *string
+	ExpectedBucketOwner *string
+}
+
+func (input *GetObjectRetentionInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetObjectRetentionInput) GetKey() string {
+	return input.Key
+}
+
+func (input *GetObjectRetentionInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryRetention)
+	setQuery(args, QueryVersionID, input.VersionId)
+
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetObjectRetentionOutput struct {
+	RequestCharged *string
+
+	Payload *types.ObjectLockRetention
+}
+
+func (output *GetObjectRetentionOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	var payload types.ObjectLockRetention
+	if err := unmarshalXMLBody(resp, "GetObjectRetention", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetObjectRetention(ctx context.Context, input *GetObjectRetentionInput, optFns ...func(*Options)) (*GetObjectRetentionOutput, *Metadata, error) {
+	return PerformCall[*GetObjectRetentionInput, *GetObjectRetentionOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*GetPublicAccessBlockInput)(nil)
+
+type GetPublicAccessBlockInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *GetPublicAccessBlockInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *GetPublicAccessBlockInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	req.URI().QueryArgs().SetNoValue(QueryPublicAccessBlock)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type GetPublicAccessBlockOutput struct {
+	Payload *types.PublicAccessBlockConfiguration
+}
+
+func (output *GetPublicAccessBlockOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.PublicAccessBlockConfiguration
+	if err := unmarshalXMLBody(resp, "GetPublicAccessBlock", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) GetPublicAccessBlock(ctx context.Context, input *GetPublicAccessBlockInput, optFns ...func(*Options)) (*GetPublicAccessBlockOutput, *Metadata, error) {
+	return PerformCall[*GetPublicAccessBlockInput, *GetPublicAccessBlockOutput](ctx, c, input, optFns...)
+}
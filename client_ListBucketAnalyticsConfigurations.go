@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*ListBucketAnalyticsConfigurationsInput)(nil)
+
+type ListBucketAnalyticsConfigurationsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// ContinuationToken is the NextContinuationToken of the previous page.
+	ContinuationToken *string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *ListBucketAnalyticsConfigurationsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *ListBucketAnalyticsConfigurationsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryAnalytics)
+	setQuery(args, QueryContinuationToken, input.ContinuationToken)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type ListBucketAnalyticsConfigurationsOutput struct {
+	Payload *types.ListBucketAnalyticsConfigurationResult
+}
+
+func (output *ListBucketAnalyticsConfigurationsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.ListBucketAnalyticsConfigurationResult
+	if err := unmarshalXMLBody(resp, "ListBucketAnalyticsConfigurations", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) ListBucketAnalyticsConfigurations(ctx context.Context, input *ListBucketAnalyticsConfigurationsInput, optFns ...func(*Options)) (*ListBucketAnalyticsConfigurationsOutput, *Metadata, error) {
+	return PerformCall[*ListBucketAnalyticsConfigurationsInput, *ListBucketAnalyticsConfigurationsOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*ListBucketIntelligentTieringConfigurationsInput)(nil)
+
+type ListBucketIntelligentTieringConfigurationsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// ContinuationToken is the NextContinuationToken of the previous page.
+	ContinuationToken *string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *ListBucketIntelligentTieringConfigurationsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *ListBucketIntelligentTieringConfigurationsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryIntelligentTiering)
+	setQuery(args, QueryContinuationToken, input.ContinuationToken)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type ListBucketIntelligentTieringConfigurationsOutput struct {
+	Payload *types.ListBucketIntelligentTieringConfigurationsOutput
+}
+
+func (output *ListBucketIntelligentTieringConfigurationsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.ListBucketIntelligentTieringConfigurationsOutput
+	if err := unmarshalXMLBody(resp, "ListBucketIntelligentTieringConfigurations", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) ListBucketIntelligentTieringConfigurations(ctx context.Context, input *ListBucketIntelligentTieringConfigurationsInput, optFns ...func(*Options)) (*ListBucketIntelligentTieringConfigurationsOutput, *Metadata, error) {
+	return PerformCall[*ListBucketIntelligentTieringConfigurationsInput, *ListBucketIntelligentTieringConfigurationsOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*ListBucketInventoryConfigurationsInput)(nil)
+
+type ListBucketInventoryConfigurationsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// ContinuationToken is the NextContinuationToken of the previous page.
+	ContinuationToken *string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *ListBucketInventoryConfigurationsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *ListBucketInventoryConfigurationsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryInventory)
+	setQuery(args, QueryContinuationToken, input.ContinuationToken)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type ListBucketInventoryConfigurationsOutput struct {
+	Payload *types.ListInventoryConfigurationsResult
+}
+
+func (output *ListBucketInventoryConfigurationsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.ListInventoryConfigurationsResult
+	if err := unmarshalXMLBody(resp, "ListBucketInventoryConfigurations", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) ListBucketInventoryConfigurations(ctx context.Context, input *ListBucketInventoryConfigurationsInput, optFns ...func(*Options)) (*ListBucketInventoryConfigurationsOutput, *Metadata, error) {
+	return PerformCall[*ListBucketInventoryConfigurationsInput, *ListBucketInventoryConfigurationsOutput](ctx, c, input, optFns...)
+}
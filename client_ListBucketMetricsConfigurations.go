@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*ListBucketMetricsConfigurationsInput)(nil)
+
+type ListBucketMetricsConfigurationsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// ContinuationToken is the NextContinuationToken of the previous page.
+	ContinuationToken *string
+
+	ExpectedBucketOwner *string
+}
+
+func (input *ListBucketMetricsConfigurationsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *ListBucketMetricsConfigurationsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryMetrics)
+	setQuery(args, QueryContinuationToken, input.ContinuationToken)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type ListBucketMetricsConfigurationsOutput struct {
+	Payload *types.ListMetricsConfigurationsResult
+}
+
+func (output *ListBucketMetricsConfigurationsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	var payload types.ListMetricsConfigurationsResult
+	if err := unmarshalXMLBody(resp, "ListBucketMetricsConfigurations", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) ListBucketMetricsConfigurations(ctx context.Context, input *ListBucketMetricsConfigurationsInput, optFns ...func(*Options)) (*ListBucketMetricsConfigurationsOutput, *Metadata, error) {
+	return PerformCall[*ListBucketMetricsConfigurationsInput, *ListBucketMetricsConfigurationsOutput](ctx, c, input, optFns...)
+}
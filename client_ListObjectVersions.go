@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*ListObjectVersionsInput)(nil)
+
+type ListObjectVersionsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	Delimiter       *string
+	EncodingType    *string
+	KeyMarker       *string
+	MaxKeys         *string
+	Prefix          *string
+	VersionIdMarker *string
+
+	ExpectedBucketOwner      *string
+	RequestPayer             *string
+	OptionalObjectAttributes *string
+}
+
+func (input *ListObjectVersionsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *ListObjectVersionsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodGet)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryVersions)
+	setQuery(args, QueryDelimiter, input.Delimiter)
+	setQuery(args, QueryEncodingType, input.EncodingType)
+	setQuery(args, QueryKeyMarker, input.KeyMarker)
+	setQuery(args, QueryMaxKeys, input.MaxKeys)
+	setQuery(args, QueryPrefix, input.Prefix)
+	setQuery(args, QueryVersionIDMarker, input.VersionIdMarker)
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzOptionalObjectAttributes, input.OptionalObjectAttributes)
+
+	return nil
+}
+
+type ListObjectVersionsOutput struct {
+	RequestCharged *string
+
+	Payload *types.ListVersionsResult
+}
+
+func (output *ListObjectVersionsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	var payload types.ListVersionsResult
+	if err := unmarshalXMLBody(resp, "ListObjectVersions", &payload); err != nil {
+		return err
+	}
+
+	output.Payload = &payload
+	return nil
+}
+
+func (c *Client) ListObjectVersions(ctx context.Context, input *ListObjectVersionsInput, optFns ...func(*Options)) (*ListObjectVersionsOutput, *Metadata, error) {
+	return PerformCall[*ListObjectVersionsInput, *ListObjectVersionsOutput](ctx, c, input, optFns...)
+}
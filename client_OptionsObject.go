@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*OptionsObjectInput)(nil)
+var _ RequiredPreflightInterface = (*OptionsObjectInput)(nil)
+
+// OptionsObjectInput describes a CORS preflight request.
+// Browsers do not sign preflight requests, use
+// [github.com/s3hobby/client/pkg/signer.NewAnonymousSigner] to reproduce their behavior.
+type OptionsObjectInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	// Origin is mandatory
+	Origin string
+
+	// AccessControlRequestMethod is mandatory
+	AccessControlRequestMethod string
+
+	AccessControlRequestHeaders *string
+}
+
+func (input *OptionsObjectInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *OptionsObjectInput) GetKey() string {
+	return input.Key
+}
+
+func (input *OptionsObjectInput) GetOrigin() string {
+	return input.Origin
+}
+
+func (input *OptionsObjectInput) GetAccessControlRequestMethod() string {
+	return input.AccessControlRequestMethod
+}
+
+func (input *OptionsObjectInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodOptions)
+
+	req.Header.Set(HeaderOrigin, input.Origin)
+	req.Header.Set(HeaderAccessControlRequestMethod, input.AccessControlRequestMethod)
+	setHeader(&req.Header, HeaderAccessControlRequestHeaders, input.AccessControlRequestHeaders)
+
+	return nil
+}
+
+type OptionsObjectOutput struct {
+	AccessControlAllowOrigin      *string
+	AccessControlAllowMethods     *string
+	AccessControlAllowHeaders     *string
+	AccessControlExposeHeaders    *string
+	AccessControlMaxAge           *string
+	AccessControlAllowCredentials *string
+	Vary                          *string
+}
+
+func (output *OptionsObjectOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.AccessControlAllowOrigin = extractHeader(&resp.Header, HeaderAccessControlAllowOrigin)
+	output.AccessControlAllowMethods = extractHeader(&resp.Header, HeaderAccessControlAllowMethods)
+	output.AccessControlAllowHeaders = extractHeader(&resp.Header, HeaderAccessControlAllowHeaders)
+	output.AccessControlExposeHeaders = extractHeader(&resp.Header, HeaderAccessControlExposeHeaders)
+	output.AccessControlMaxAge = extractHeader(&resp.Header, HeaderAccessControlMaxAge)
+	output.AccessControlAllowCredentials = extractHeader(&resp.Header, HeaderAccessControlAllowCredentials)
+	output.Vary = extractHeader(&resp.Header, HeaderVary)
+
+	return nil
+}
+
+// OptionsObject sends a CORS preflight request, useful to check a CORS configuration.
+func (c *Client) OptionsObject(ctx context.Context, input *OptionsObjectInput, optFns ...func(*Options)) (*OptionsObjectOutput, *Metadata, error) {
+	return PerformCall[*OptionsObjectInput, *OptionsObjectOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestOptionsObject(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+			assert.Equal(t, "http://bucket.s3.dev-1.example.com/key", ctx.URI().String())
+			assert.Equal(t, fasthttp.MethodOptions, string(ctx.Method()))
+			assert.Equal(t, "http://www.example.com", string(ctx.Request.Header.Peek(HeaderOrigin)))
+			assert.Equal(t, "PUT", string(ctx.Request.Header.Peek(HeaderAccessControlRequestMethod)))
+			assert.Equal(t, "x-amz-meta-tag", string(ctx.Request.Header.Peek(HeaderAccessControlRequestHeaders)))
+
+			ctx.Response.Header.Set(HeaderAccessControlAllowOrigin, "http://www.example.com")
+			ctx.Response.Header.Set(HeaderAccessControlAllowMethods, "PUT")
+			ctx.Response.Header.Set(HeaderAccessControlAllowHeaders, "x-amz-meta-tag")
+			ctx.Response.Header.Set(HeaderAccessControlMaxAge, "3000")
+			ctx.Response.Header.Set(HeaderVary, "Origin")
+		})
+
+		out, _, err := c.OptionsObject(t.Context(), &OptionsObjectInput{
+			Bucket:                      "bucket",
+			Key:                         "key",
+			Origin:                      "http://www.example.com",
+			AccessControlRequestMethod:  "PUT",
+			AccessControlRequestHeaders: utils.ToPtr("x-amz-meta-tag"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, &OptionsObjectOutput{
+			AccessControlAllowOrigin:  utils.ToPtr("http://www.example.com"),
+			AccessControlAllowMethods: utils.ToPtr("PUT"),
+			AccessControlAllowHeaders: utils.ToPtr("x-amz-meta-tag"),
+			AccessControlMaxAge:       utils.ToPtr("3000"),
+			Vary:                      utils.ToPtr("Origin"),
+		}, out)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+			ctx.SetStatusCode(fasthttp.StatusForbidden)
+			ctx.Response.SetBodyString(`<Error><Code>AccessForbidden</Code><Message>CORSResponse: This CORS request is not allowed.</Message></Error>`)
+		})
+
+		_, _, err := c.OptionsObject(t.Context(), &OptionsObjectInput{
+			Bucket:                     "bucket",
+			Key:                        "key",
+			Origin:                     "http://evil.example.com",
+			AccessControlRequestMethod: "PUT",
+		})
+
+		var serverErr *ServerSideError
+		require.ErrorAs(t, err, &serverErr)
+		require.Equal(t, "AccessForbidden", serverErr.Code)
+	})
+
+	t.Run("missing origin", func(t *testing.T) {
+		testHandleCall_ko(t, &OptionsObjectInput{
+			Bucket:                     "bucket",
+			Key:                        "key",
+			AccessControlRequestMethod: "PUT",
+		}, errors.New("origin is mandatory"))
+	})
+
+	t.Run("missing method", func(t *testing.T) {
+		testHandleCall_ko(t, &OptionsObjectInput{
+			Bucket: "bucket",
+			Key:    "key",
+			Origin: "http://www.example.com",
+		}, errors.New("access control request method is mandatory"))
+	})
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketAclInput)(nil)
+
+type PutBucketAclInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ACL *string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	GrantFullControl    *string
+	GrantRead           *string
+	GrantReadACP        *string
+	GrantWrite          *string
+	GrantWriteACP       *string
+	ExpectedBucketOwner *string
+
+	// AccessControlPolicy cannot be used along with ACL and Grant* headers.
+	AccessControlPolicy *types.AccessControlPolicy
+}
+
+func (input *PutBucketAclInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketAclInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryACL)
+
+	if input.AccessControlPolicy != nil {
+		if err := setXMLBody(req, input.AccessControlPolicy); err != nil {
+			return err
+		}
+	}
+
+	setHeader(&req.Header, HeaderXAmzACL, input.ACL)
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzGrantFullControl, input.GrantFullControl)
+	setHeader(&req.Header, HeaderXAmzGrantRead, input.GrantRead)
+	setHeader(&req.Header, HeaderXAmzGrantReadACP, input.GrantReadACP)
+	setHeader(&req.Header, HeaderXAmzGrantWrite, input.GrantWrite)
+	setHeader(&req.Header, HeaderXAmzGrantWriteACP, input.GrantWriteACP)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketAclOutput struct{}
+
+func (*PutBucketAclOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketAcl(ctx context.Context, input *PutBucketAclInput, optFns ...func(*Options)) (*PutBucketAclOutput, *Metadata, error) {
+	return PerformCall[*PutBucketAclInput, *PutBucketAclOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketAnalyticsConfigurationInput)(nil)
+var _ RequiredIDInterface = (*PutBucketAnalyticsConfigurationInput)(nil)
+
+type PutBucketAnalyticsConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+
+	AnalyticsConfiguration types.AnalyticsConfiguration
+}
+
+func (input *PutBucketAnalyticsConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketAnalyticsConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *PutBucketAnalyticsConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryAnalytics)
+	args.Set(QueryID, input.Id)
+
+	if err := setXMLBody(req, &input.AnalyticsConfiguration); err != nil {
+		return err
+	}
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketAnalyticsConfigurationOutput struct{}
+
+func (*PutBucketAnalyticsConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketAnalyticsConfiguration(ctx context.Context, input *PutBucketAnalyticsConfigurationInput, optFns ...func(*Options)) (*PutBucketAnalyticsConfigurationOutput, *Metadata, error) {
+	return PerformCall[*PutBucketAnalyticsConfigurationInput, *PutBucketAnalyticsConfigurationOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketCorsInput)(nil)
+
+type PutBucketCorsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	ExpectedBucketOwner *string
+
+	CORSConfiguration types.CORSConfiguration
+}
+
+func (input *PutBucketCorsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketCorsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryCors)
+
+	if err := setXMLBody(req, &input.CORSConfiguration); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketCorsOutput struct{}
+
+func (*PutBucketCorsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketCors(ctx context.Context, input *PutBucketCorsInput, optFns ...func(*Options)) (*PutBucketCorsOutput, *Metadata, error) {
+	return PerformCall[*PutBucketCorsInput, *PutBucketCorsOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+	"github.com/s3hobby/client/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+const testCORSConfiguration = `<CORSConfiguration>` +
+	`<CORSRule><ID>rule1</ID><AllowedHeader>*</AllowedHeader>` +
+	`<AllowedMethod>PUT</AllowedMethod><AllowedMethod>POST</AllowedMethod>` +
+	`<AllowedOrigin>http://www.example.com</AllowedOrigin>` +
+	`<ExposeHeader>x-amz-server-side-encryption</ExposeHeader><MaxAgeSeconds>3000</MaxAgeSeconds></CORSRule>` +
+	`<CORSRule><AllowedMethod>GET</AllowedMethod><AllowedOrigin>*</AllowedOrigin></CORSRule>` +
+	`</CORSConfiguration>`
+
+var testCORSRules = []types.CORSRule{
+	{
+		ID:             utils.ToPtr("rule1"),
+		AllowedHeaders: []string{"*"},
+		AllowedMethods: []string{"PUT", "POST"},
+		AllowedOrigins: []string{"http://www.example.com"},
+		ExposeHeaders:  []string{"x-amz-server-side-encryption"},
+		MaxAgeSeconds:  utils.ToPtr("3000"),
+	},
+	{
+		AllowedMethods: []string{"GET"},
+		AllowedOrigins: []string{"*"},
+	},
+}
+
+func TestPutBucketCors(t *testing.T) {
+	c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		assert.Equal(t, "http://bucket.s3.dev-1.example.com/?cors", ctx.URI().String())
+		assert.Equal(t, fasthttp.MethodPut, string(ctx.Method()))
+		assert.Equal(t, testCORSConfiguration, string(ctx.Request.Body()))
+		assert.NotEmpty(t, ctx.Request.Header.Peek(HeaderContentMD5))
+	})
+
+	_, _, err := c.PutBucketCors(t.Context(), &PutBucketCorsInput{
+		Bucket:            "bucket",
+		CORSConfiguration: types.CORSConfiguration{CORSRules: testCORSRules},
+	})
+	require.NoError(t, err)
+}
+
+func TestGetBucketCors(t *testing.T) {
+	c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		assert.Equal(t, "http://bucket.s3.dev-1.example.com/?cors", ctx.URI().String())
+		assert.Equal(t, fasthttp.MethodGet, string(ctx.Method()))
+
+		ctx.Response.SetBodyString(testCORSConfiguration)
+	})
+
+	out, _, err := c.GetBucketCors(t.Context(), &GetBucketCorsInput{Bucket: "bucket"})
+	require.NoError(t, err)
+	require.Equal(t, &types.CORSConfiguration{CORSRules: testCORSRules}, out.Payload)
+}
+
+func TestDeleteBucketCors(t *testing.T) {
+	c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		assert.Equal(t, "http://bucket.s3.dev-1.example.com/?cors", ctx.URI().String())
+		assert.Equal(t, fasthttp.MethodDelete, string(ctx.Method()))
+
+		ctx.SetStatusCode(fasthttp.StatusNoContent)
+	})
+
+	_, _, err := c.DeleteBucketCors(t.Context(), &DeleteBucketCorsInput{Bucket: "bucket"})
+	require.NoError(t, err)
+}
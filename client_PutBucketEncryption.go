@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketEncryptionInput)(nil)
+
+type PutBucketEncryptionInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	ExpectedBucketOwner *string
+
+	ServerSideEncryptionConfiguration types.ServerSideEncryptionConfiguration
+}
+
+func (input *PutBucketEncryptionInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketEncryptionInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryEncryption)
+
+	if err := setXMLBody(req, &input.ServerSideEncryptionConfiguration); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketEncryptionOutput struct{}
+
+func (*PutBucketEncryptionOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketEncryption(ctx context.Context, input *PutBucketEncryptionInput, optFns ...func(*Options)) (*PutBucketEncryptionOutput, *Metadata, error) {
+	return PerformCall[*PutBucketEncryptionInput, *PutBucketEncryptionOutput](ctx, c, input, optFns...)
+}
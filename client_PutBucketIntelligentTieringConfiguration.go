@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketIntelligentTieringConfigurationInput)(nil)
+var _ RequiredIDInterface = (*PutBucketIntelligentTieringConfigurationInput)(nil)
+
+type PutBucketIntelligentTieringConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+
+	IntelligentTieringConfiguration types.IntelligentTieringConfiguration
+}
+
+func (input *PutBucketIntelligentTieringConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketIntelligentTieringConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *PutBucketIntelligentTieringConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryIntelligentTiering)
+	args.Set(QueryID, input.Id)
+
+	if err := setXMLBody(req, &input.IntelligentTieringConfiguration); err != nil {
+		return err
+	}
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketIntelligentTieringConfigurationOutput struct{}
+
+func (*PutBucketIntelligentTieringConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketIntelligentTieringConfiguration(ctx context.Context, input *PutBucketIntelligentTieringConfigurationInput, optFns ...func(*Options)) (*PutBucketIntelligentTieringConfigurationOutput, *Metadata, error) {
+	return PerformCall[*PutBucketIntelligentTieringConfigurationInput, *PutBucketIntelligentTieringConfigurationOutput](ctx, c, input, optFns...)
+}
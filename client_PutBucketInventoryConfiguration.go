@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketInventoryConfigurationInput)(nil)
+var _ RequiredIDInterface = (*PutBucketInventoryConfigurationInput)(nil)
+
+type PutBucketInventoryConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+
+	InventoryConfiguration types.InventoryConfiguration
+}
+
+func (input *PutBucketInventoryConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketInventoryConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *PutBucketInventoryConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryInventory)
+	args.Set(QueryID, input.Id)
+
+	if err := setXMLBody(req, &input.InventoryConfiguration); err != nil {
+		return err
+	}
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketInventoryConfigurationOutput struct{}
+
+func (*PutBucketInventoryConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketInventoryConfiguration(ctx context.Context, input *PutBucketInventoryConfigurationInput, optFns ...func(*Options)) (*PutBucketInventoryConfigurationOutput, *Metadata, error) {
+	return PerformCall[*PutBucketInventoryConfigurationInput, *PutBucketInventoryConfigurationOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketLifecycleConfigurationInput)(nil)
+
+type PutBucketLifecycleConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5                         *string
+	ChecksumAlgorithm                  *string
+	ExpectedBucketOwner                *string
+	TransitionDefaultMinimumObjectSize *string
+
+	LifecycleConfiguration types.LifecycleConfiguration
+}
+
+func (input *PutBucketLifecycleConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketLifecycleConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryLifecycle)
+
+	if err := setXMLBody(req, &input.LifecycleConfiguration); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+	setHeader(&req.Header, HeaderXAmzTransitionDefaultMinimumObjectSize, input.TransitionDefaultMinimumObjectSize)
+
+	return nil
+}
+
+type PutBucketLifecycleConfigurationOutput struct {
+	TransitionDefaultMinimumObjectSize *string
+}
+
+func (output *PutBucketLifecycleConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.TransitionDefaultMinimumObjectSize = extractHeader(&resp.Header, HeaderXAmzTransitionDefaultMinimumObjectSize)
+
+	return nil
+}
+
+func (c *Client) PutBucketLifecycleConfiguration(ctx context.Context, input *PutBucketLifecycleConfigurationInput, optFns ...func(*Options)) (*PutBucketLifecycleConfigurationOutput, *Metadata, error) {
+	return PerformCall[*PutBucketLifecycleConfigurationInput, *PutBucketLifecycleConfigurationOutput](ctx, c, input, optFns...)
+}
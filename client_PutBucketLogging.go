@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketLoggingInput)(nil)
+
+type PutBucketLoggingInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	ExpectedBucketOwner *string
+
+	BucketLoggingStatus types.BucketLoggingStatus
+}
+
+func (input *PutBucketLoggingInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketLoggingInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryLogging)
+
+	if err := setXMLBody(req, &input.BucketLoggingStatus); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketLoggingOutput struct{}
+
+func (*PutBucketLoggingOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketLogging(ctx context.Context, input *PutBucketLoggingInput, optFns ...func(*Options)) (*PutBucketLoggingOutput, *Metadata, error) {
+	return PerformCall[*PutBucketLoggingInput, *PutBucketLoggingOutput](ctx, c, input, optFns...)
+}
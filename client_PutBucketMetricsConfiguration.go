@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketMetricsConfigurationInput)(nil)
+var _ RequiredIDInterface = (*PutBucketMetricsConfigurationInput)(nil)
+
+type PutBucketMetricsConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Id is mandatory
+	Id string
+
+	ExpectedBucketOwner *string
+
+	MetricsConfiguration types.MetricsConfiguration
+}
+
+func (input *PutBucketMetricsConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketMetricsConfigurationInput) GetID() string {
+	return input.Id
+}
+
+func (input *PutBucketMetricsConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryMetrics)
+	args.Set(QueryID, input.Id)
+
+	if err := setXMLBody(req, &input.MetricsConfiguration); err != nil {
+		return err
+	}
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketMetricsConfigurationOutput struct{}
+
+func (*PutBucketMetricsConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketMetricsConfiguration(ctx context.Context, input *PutBucketMetricsConfigurationInput, optFns ...func(*Options)) (*PutBucketMetricsConfigurationOutput, *Metadata, error) {
+	return PerformCall[*PutBucketMetricsConfigurationInput, *PutBucketMetricsConfigurationOutput](ctx, c, input, optFns...)
+}
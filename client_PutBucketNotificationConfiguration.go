@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketNotificationConfigurationInput)(nil)
+
+type PutBucketNotificationConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ExpectedBucketOwner       *string
+	SkipDestinationValidation *string
+
+	// NotificationConfiguration replaces the whole configuration,
+	// an empty configuration disables the notifications.
+	NotificationConfiguration types.NotificationConfiguration
+}
+
+func (input *PutBucketNotificationConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketNotificationConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryNotification)
+
+	if err := setXMLBody(req, &input.NotificationConfiguration); err != nil {
+		return err
+	}
+
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+	setHeader(&req.Header, HeaderXAmzSkipDestinationValidation, input.SkipDestinationValidation)
+
+	return nil
+}
+
+type PutBucketNotificationConfigurationOutput struct{}
+
+func (*PutBucketNotificationConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketNotificationConfiguration(ctx context.Context, input *PutBucketNotificationConfigurationInput, optFns ...func(*Options)) (*PutBucketNotificationConfigurationOutput, *Metadata, error) {
+	return PerformCall[*PutBucketNotificationConfigurationInput, *PutBucketNotificationConfigurationOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketOwnershipControlsInput)(nil)
+
+type PutBucketOwnershipControlsInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	ExpectedBucketOwner *string
+
+	OwnershipControls types.OwnershipControls
+}
+
+func (input *PutBucketOwnershipControlsInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketOwnershipControlsInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryOwnershipControls)
+
+	if err := setXMLBody(req, &input.OwnershipControls); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketOwnershipControlsOutput struct{}
+
+func (*PutBucketOwnershipControlsOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketOwnershipControls(ctx context.Context, input *PutBucketOwnershipControlsInput, optFns ...func(*Options)) (*PutBucketOwnershipControlsOutput, *Metadata, error) {
+	return PerformCall[*PutBucketOwnershipControlsInput, *PutBucketOwnershipControlsOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketPolicyInput)(nil)
+var _ RequiredPolicyInterface = (*PutBucketPolicyInput)(nil)
+
+type PutBucketPolicyInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Policy is mandatory, it is sent as is.
+	Policy json.RawMessage
+
+	ContentMD5                    *string
+	ChecksumAlgorithm             *string
+	ConfirmRemoveSelfBucketAccess *string
+	ExpectedBucketOwner           *string
+}
+
+func (input *PutBucketPolicyInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketPolicyInput) GetPolicy() []byte {
+	return input.Policy
+}
+
+func (input *PutBucketPolicyInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryPolicy)
+
+	req.SetBody(input.Policy)
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzConfirmRemoveSelfBucketAccess, input.ConfirmRemoveSelfBucketAccess)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketPolicyOutput struct{}
+
+func (*PutBucketPolicyOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	return expectStatus(resp, fasthttp.StatusOK, fasthttp.StatusNoContent)
+}
+
+func (c *Client) PutBucketPolicy(ctx context.Context, input *PutBucketPolicyInput, optFns ...func(*Options)) (*PutBucketPolicyOutput, *Metadata, error) {
+	return PerformCall[*PutBucketPolicyInput, *PutBucketPolicyOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"crypto/md5" //nolint:gosec // Content-MD5 is part of the S3 protocol
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+	"github.com/s3hobby/client/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+const testPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`
+
+func TestPutBucketPolicy(t *testing.T) {
+	sum := md5.Sum([]byte(testPolicy)) //nolint:gosec // Content-MD5 is part of the S3 protocol
+
+	for _, statusCode := range []int{fasthttp.StatusOK, fasthttp.StatusNoContent} {
+		t.Run(fasthttp.StatusMessage(statusCode), func(t *testing.T) {
+			c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+				assert.Equal(t, "http://bucket.s3.dev-1.example.com/?policy", ctx.URI().String())
+				assert.Equal(t, fasthttp.MethodPut, string(ctx.Method()))
+				assert.Equal(t, testPolicy, string(ctx.Request.Body()))
+				assert.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), string(ctx.Request.Header.Peek(HeaderContentMD5)))
+
+				ctx.SetStatusCode(statusCode)
+			})
+
+			_, _, err := c.PutBucketPolicy(t.Context(), &PutBucketPolicyInput{
+				Bucket: "bucket",
+				Policy: json.RawMessage(testPolicy),
+			})
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("unexpected status", func(t *testing.T) {
+		c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+			ctx.SetStatusCode(fasthttp.StatusBadRequest)
+			ctx.Response.SetBodyString(`<Error><Code>MalformedPolicy</Code></Error>`)
+		})
+
+		_, _, err := c.PutBucketPolicy(t.Context(), &PutBucketPolicyInput{
+			Bucket: "bucket",
+			Policy: json.RawMessage(`{}`),
+		})
+
+		var serverErr *ServerSideError
+		require.ErrorAs(t, err, &serverErr)
+		require.Equal(t, "MalformedPolicy", serverErr.Code)
+	})
+
+	t.Run("missing policy", func(t *testing.T) {
+		testHandleCall_ko(t, &PutBucketPolicyInput{Bucket: "bucket"}, errors.New("policy is mandatory"))
+	})
+}
+
+func TestGetBucketPolicy(t *testing.T) {
+	c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		assert.Equal(t, "http://bucket.s3.dev-1.example.com/?policy", ctx.URI().String())
+		assert.Equal(t, fasthttp.MethodGet, string(ctx.Method()))
+
+		ctx.Response.SetBodyString(testPolicy)
+	})
+
+	out, _, err := c.GetBucketPolicy(t.Context(), &GetBucketPolicyInput{Bucket: "bucket"})
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(testPolicy), out.Policy)
+}
+
+func TestGetBucketPolicyStatus(t *testing.T) {
+	c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+		assert.Equal(t, "http://bucket.s3.dev-1.example.com/?policyStatus", ctx.URI().String())
+
+		ctx.Response.SetBodyString(`<PolicyStatus xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><IsPublic>true</IsPublic></PolicyStatus>`)
+	})
+
+	out, _, err := c.GetBucketPolicyStatus(t.Context(), &GetBucketPolicyStatusInput{Bucket: "bucket"})
+	require.NoError(t, err)
+	require.Equal(t, &types.PolicyStatus{IsPublic: utils.ToPtr("true")}, out.Payload)
+}
+
+func TestPublicAccessBlock(t *testing.T) {
+	const body = `<PublicAccessBlockConfiguration>` +
+		`<BlockPublicAcls>true</BlockPublicAcls><IgnorePublicAcls>true</IgnorePublicAcls>` +
+		`<BlockPublicPolicy>false</BlockPublicPolicy><RestrictPublicBuckets>false</RestrictPublicBuckets>` +
+		`</PublicAccessBlockConfiguration>`
+
+	configuration := types.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       utils.ToPtr("true"),
+		IgnorePublicAcls:      utils.ToPtr("true"),
+		BlockPublicPolicy:     utils.ToPtr("false"),
+		RestrictPublicBuckets: utils.ToPtr("false"),
+	}
+
+	t.Run("put", func(t *testing.T) {
+		c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+			assert.Equal(t, "http://bucket.s3.dev-1.example.com/?publicAccessBlock", ctx.URI().String())
+			assert.Equal(t, fasthttp.MethodPut, string(ctx.Method()))
+			assert.Equal(t, body, string(ctx.Request.Body()))
+			assert.NotEmpty(t, ctx.Request.Header.Peek(HeaderContentMD5))
+		})
+
+		_, _, err := c.PutPublicAccessBlock(t.Context(), &PutPublicAccessBlockInput{
+			Bucket:                         "bucket",
+			PublicAccessBlockConfiguration: configuration,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+			assert.Equal(t, "http://bucket.s3.dev-1.example.com/?publicAccessBlock", ctx.URI().String())
+			assert.Equal(t, fasthttp.MethodGet, string(ctx.Method()))
+
+			ctx.Response.SetBodyString(body)
+		})
+
+		out, _, err := c.GetPublicAccessBlock(t.Context(), &GetPublicAccessBlockInput{Bucket: "bucket"})
+		require.NoError(t, err)
+		require.Equal(t, &configuration, out.Payload)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		c := newTestClient(t, func(ctx *fasthttp.RequestCtx) {
+			assert.Equal(t, fasthttp.MethodDelete, string(ctx.Method()))
+
+			ctx.SetStatusCode(fasthttp.StatusNoContent)
+		})
+
+		_, _, err := c.DeletePublicAccessBlock(t.Context(), &DeletePublicAccessBlockInput{Bucket: "bucket"})
+		require.NoError(t, err)
+	})
+}
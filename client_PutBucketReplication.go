@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketReplicationInput)(nil)
+
+type PutBucketReplicationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	Token               *string
+	ExpectedBucketOwner *string
+
+	ReplicationConfiguration types.ReplicationConfiguration
+}
+
+func (input *PutBucketReplicationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketReplicationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryReplication)
+
+	if err := setXMLBody(req, &input.ReplicationConfiguration); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzBucketObjectLockToken, input.Token)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketReplicationOutput struct{}
+
+func (*PutBucketReplicationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketReplication(ctx context.Context, input *PutBucketReplicationInput, optFns ...func(*Options)) (*PutBucketReplicationOutput, *Metadata, error) {
+	return PerformCall[*PutBucketReplicationInput, *PutBucketReplicationOutput](ctx, c, input, optFns...)
+}
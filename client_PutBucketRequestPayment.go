@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketRequestPaymentInput)(nil)
+
+type PutBucketRequestPaymentInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	ExpectedBucketOwner *string
+
+	RequestPaymentConfiguration types.RequestPaymentConfiguration
+}
+
+func (input *PutBucketRequestPaymentInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketRequestPaymentInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryRequestPayment)
+
+	if err := setXMLBody(req, &input.RequestPaymentConfiguration); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketRequestPaymentOutput struct{}
+
+func (*PutBucketRequestPaymentOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketRequestPayment(ctx context.Context, input *PutBucketRequestPaymentInput, optFns ...func(*Options)) (*PutBucketRequestPaymentOutput, *Metadata, error) {
+	return PerformCall[*PutBucketRequestPaymentInput, *PutBucketRequestPaymentOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketTaggingInput)(nil)
+
+type PutBucketTaggingInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	ExpectedBucketOwner *string
+
+	Tagging types.Tagging
+}
+
+func (input *PutBucketTaggingInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketTaggingInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryTagging)
+
+	if err := setXMLBody(req, &input.Tagging); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketTaggingOutput struct{}
+
+func (*PutBucketTaggingOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	return expectStatus(resp, fasthttp.StatusOK, fasthttp.StatusNoContent)
+}
+
+func (c *Client) PutBucketTagging(ctx context.Context, input *PutBucketTaggingInput, optFns ...func(*Options)) (*PutBucketTaggingOutput, *Metadata, error) {
+	return PerformCall[*PutBucketTaggingInput, *PutBucketTaggingOutput](ctx, c, input, optFns...)
+}
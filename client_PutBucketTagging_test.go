@@ -0,0 +1,46 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/s3hobby/client/pkg/fasthttptesting"
+	"github.com/s3hobby/client/pkg/signer"
+	"github.com/s3hobby/client/pkg/utils"
+	"github.com/s3hobby/client/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestPutBucketTagging(t *testing.T) {
+	for _, statusCode := range []int{fasthttp.StatusOK, fasthttp.StatusNoContent} {
+		t.Run(fasthttp.StatusMessage(statusCode), func(t *testing.T) {
+			srv := fasthttptesting.NewInmemoryTester(func(ctx *fasthttp.RequestCtx) {
+				assert.Equal(t, "http://bucket.s3.dev-1.example.com/?tagging", ctx.URI().String())
+				assert.Equal(t, fasthttp.MethodPut, string(ctx.Method()))
+				assert.Equal(t, "<Tagging><TagSet><Tag><Key>env</Key><Value>dev</Value></Tag></TagSet></Tagging>", string(ctx.Request.Body()))
+				assert.NotEmpty(t, ctx.Request.Header.Peek(HeaderContentMD5))
+
+				ctx.SetStatusCode(statusCode)
+			})
+			defer srv.Close()
+
+			c, err := New(&Options{
+				SiginingRegion: "dev-1",
+				EndpointHost:   "s3.dev-1.example.com",
+				Signer:         signer.NewAnonymousSigner(),
+				HTTPClient:     srv.Client(),
+			})
+			require.NoError(t, err)
+
+			_, _, err = c.PutBucketTagging(t.Context(), &PutBucketTaggingInput{
+				Bucket: "bucket",
+				Tagging: types.Tagging{
+					TagSet: []types.Tag{{Key: utils.ToPtr("env"), Value: utils.ToPtr("dev")}},
+				},
+			})
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketVersioningInput)(nil)
+
+type PutBucketVersioningInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	MFA                 *string
+	ExpectedBucketOwner *string
+
+	VersioningConfiguration types.VersioningConfiguration
+}
+
+func (input *PutBucketVersioningInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketVersioningInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryVersioning)
+
+	if err := setXMLBody(req, &input.VersioningConfiguration); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzMFA, input.MFA)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketVersioningOutput struct{}
+
+func (*PutBucketVersioningOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketVersioning(ctx context.Context, input *PutBucketVersioningInput, optFns ...func(*Options)) (*PutBucketVersioningOutput, *Metadata, error) {
+	return PerformCall[*PutBucketVersioningInput, *PutBucketVersioningOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutBucketWebsiteInput)(nil)
+
+type PutBucketWebsiteInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	ExpectedBucketOwner *string
+
+	WebsiteConfiguration types.WebsiteConfiguration
+}
+
+func (input *PutBucketWebsiteInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutBucketWebsiteInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryWebsite)
+
+	if err := setXMLBody(req, &input.WebsiteConfiguration); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutBucketWebsiteOutput struct{}
+
+func (*PutBucketWebsiteOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutBucketWebsite(ctx context.Context, input *PutBucketWebsiteInput, optFns ...func(*Options)) (*PutBucketWebsiteOutput, *Metadata, error) {
+	return PerformCall[*PutBucketWebsiteInput, *PutBucketWebsiteOutput](ctx, c, input, optFns...)
+}
@@ -24,8 +24,16 @@ type PutObjectInput struct {
 	ContentMD5         *string
 	ContentType        *string
 	Expires            *string
-	IfMatch            *string
-	IfNoneMatch        *string
+
+	// IfMatch only writes the object when its current ETag matches.
+	// A mismatch is reported as [ErrPreconditionFailed], a concurrent
+	// conditional write as [ErrConditionalRequestConflict].
+	IfMatch *string
+
+	// IfNoneMatch set to "*" only writes the object when it does not exist yet.
+	// An existing object is reported as [ErrPreconditionFailed], a concurrent
+	// conditional write as [ErrConditionalRequestConflict].
+	IfNoneMatch *string
 
 	ACL                       *string
 	ChecksumCRC32             *string
@@ -53,8 +61,14 @@ type PutObjectInput struct {
 	StorageClass              *string
 	Tagging                   *string
 	WebsiteRedirectLocation   *string
-	WriteOffsetBytes          *string
 
+	// WriteOffsetBytes appends Body to an existing object at the given offset,
+	// which must be the current size of the object.
+	WriteOffsetBytes *string
+
+	// TrailerChecksum* send the checksum in a trailer of the aws-chunked payload
+	// instead of a header, they are exclusive with the matching Checksum* field.
+	// A pointer to "" requests the SigV4 signer to compute the checksum from Body.
 	TrailerChecksumCRC32     *string
 	TrailerChecksumCRC64NVME *string
 	TrailerChecksumSHA1      *string
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*PutObjectAclInput)(nil)
+
+type PutObjectAclInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	VersionId *string
+
+	ACL *string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	GrantFullControl    *string
+	GrantRead           *string
+	GrantReadACP        *string
+	GrantWrite          *string
+	GrantWriteACP       *string
+	RequestPayer        *string
+	ExpectedBucketOwner *string
+
+	// AccessControlPolicy cannot be used along with ACL and Grant* headers.
+	AccessControlPolicy *types.AccessControlPolicy
+}
+
+func (input *PutObjectAclInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutObjectAclInput) GetKey() string {
+	return input.Key
+}
+
+func (input *PutObjectAclInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryACL)
+	setQuery(args, QueryVersionID, input.VersionId)
+
+	if input.AccessControlPolicy != nil {
+		if err := setXMLBody(req, input.AccessControlPolicy); err != nil {
+			return err
+		}
+	}
+
+	setHeader(&req.Header, HeaderXAmzACL, input.ACL)
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzGrantFullControl, input.GrantFullControl)
+	setHeader(&req.Header, HeaderXAmzGrantRead, input.GrantRead)
+	setHeader(&req.Header, HeaderXAmzGrantReadACP, input.GrantReadACP)
+	setHeader(&req.Header, HeaderXAmzGrantWrite, input.GrantWrite)
+	setHeader(&req.Header, HeaderXAmzGrantWriteACP, input.GrantWriteACP)
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutObjectAclOutput struct {
+	RequestCharged *string
+}
+
+func (output *PutObjectAclOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	return nil
+}
+
+func (c *Client) PutObjectAcl(ctx context.Context, input *PutObjectAclInput, optFns ...func(*Options)) (*PutObjectAclOutput, *Metadata, error) {
+	return PerformCall[*PutObjectAclInput, *PutObjectAclOutput](ctx, c, input, optFns...)
+}
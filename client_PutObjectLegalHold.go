@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*PutObjectLegalHoldInput)(nil)
+
+type PutObjectLegalHoldInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	VersionId *string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	RequestPayer        *string
+	ExpectedBucketOwner *string
+
+	LegalHold types.ObjectLockLegalHold
+}
+
+func (input *PutObjectLegalHoldInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutObjectLegalHoldInput) GetKey() string {
+	return input.Key
+}
+
+func (input *PutObjectLegalHoldInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryLegalHold)
+	setQuery(args, QueryVersionID, input.VersionId)
+
+	if err := setXMLBody(req, &input.LegalHold); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutObjectLegalHoldOutput struct {
+	RequestCharged *string
+}
+
+func (output *PutObjectLegalHoldOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	return nil
+}
+
+func (c *Client) PutObjectLegalHold(ctx context.Context, input *PutObjectLegalHoldInput, optFns ...func(*Options)) (*PutObjectLegalHoldOutput, *Metadata, error) {
+	return PerformCall[*PutObjectLegalHoldInput, *PutObjectLegalHoldOutput](ctx, c, input, optFns...)
+}
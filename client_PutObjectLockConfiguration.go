@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutObjectLockConfigurationInput)(nil)
+
+type PutObjectLockConfigurationInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	RequestPayer        *string
+	Token               *string
+	ExpectedBucketOwner *string
+
+	ObjectLockConfiguration types.ObjectLockConfiguration
+}
+
+func (input *PutObjectLockConfigurationInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutObjectLockConfigurationInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryObjectLock)
+
+	if err := setXMLBody(req, &input.ObjectLockConfiguration); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzBucketObjectLockToken, input.Token)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutObjectLockConfigurationOutput struct {
+	RequestCharged *string
+}
+
+func (output *PutObjectLockConfigurationOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	return nil
+}
+
+func (c *Client) PutObjectLockConfiguration(ctx context.Context, input *PutObjectLockConfigurationInput, optFns ...func(*Options)) (*PutObjectLockConfigurationOutput, *Metadata, error) {
+	return PerformCall[*PutObjectLockConfigurationInput, *PutObjectLockConfigurationOutput](ctx, c, input, optFns...)
+}
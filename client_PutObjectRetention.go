@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*PutObjectRetentionInput)(nil)
+
+type PutObjectRetentionInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	VersionId *string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	RequestPayer        *string
+	ExpectedBucketOwner *string
+
+	// BypassGovernanceRetention allows to shorten or remove a GOVERNANCE retention.
+	BypassGovernanceRetention *string
+
+	Retention types.ObjectLockRetention
+}
+
+func (input *PutObjectRetentionInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutObjectRetentionInput) GetKey() string {
+	return input.Key
+}
+
+func (input *PutObjectRetentionInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryRetention)
+	setQuery(args, QueryVersionID, input.VersionId)
+
+	if err := setXMLBody(req, &input.Retention); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+	setHeader(&req.Header, HeaderXAmzBypassGovernanceRetention, input.BypassGovernanceRetention)
+
+	return nil
+}
+
+type PutObjectRetentionOutput struct {
+	RequestCharged *string
+}
+
+func (output *PutObjectRetentionOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+
+	return nil
+}
+
+func (c *Client) PutObjectRetention(ctx context.Context, input *PutObjectRetentionInput, optFns ...func(*Options)) (*PutObjectRetentionOutput, *Metadata, error) {
+	return PerformCall[*PutObjectRetentionInput, *PutObjectRetentionOutput](ctx, c, input, optFns...)
+}
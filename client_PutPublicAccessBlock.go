@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketInterface = (*PutPublicAccessBlockInput)(nil)
+
+type PutPublicAccessBlockInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	ContentMD5          *string
+	ChecksumAlgorithm   *string
+	ExpectedBucketOwner *string
+
+	PublicAccessBlockConfiguration types.PublicAccessBlockConfiguration
+}
+
+func (input *PutPublicAccessBlockInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *PutPublicAccessBlockInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPut)
+
+	req.URI().QueryArgs().SetNoValue(QueryPublicAccessBlock)
+
+	if err := setXMLBody(req, &input.PublicAccessBlockConfiguration); err != nil {
+		return err
+	}
+
+	setContentMD5(req, input.ContentMD5)
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type PutPublicAccessBlockOutput struct{}
+
+func (*PutPublicAccessBlockOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) PutPublicAccessBlock(ctx context.Context, input *PutPublicAccessBlockInput, optFns ...func(*Options)) (*PutPublicAccessBlockOutput, *Metadata, error) {
+	return PerformCall[*PutPublicAccessBlockInput, *PutPublicAccessBlockOutput](ctx, c, input, optFns...)
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*RestoreObjectInput)(nil)
+
+type RestoreObjectInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	VersionId *string
+
+	ChecksumAlgorithm   *string
+	RequestPayer        *string
+	ExpectedBucketOwner *string
+
+	RestoreRequest *types.RestoreRequest
+}
+
+func (input *RestoreObjectInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *RestoreObjectInput) GetKey() string {
+	return input.Key
+}
+
+func (input *RestoreObjectInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPost)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QueryRestore)
+	setQuery(args, QueryVersionID, input.VersionId)
+
+	if input.RestoreRequest != nil {
+		if err := setXMLBody(req, input.RestoreRequest); err != nil {
+			return err
+		}
+	}
+
+	setHeader(&req.Header, HeaderXAmzChecksumAlgorithm, input.ChecksumAlgorithm)
+	setHeader(&req.Header, HeaderXAmzRequestPayer, input.RequestPayer)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type RestoreObjectOutput struct {
+	// AlreadyRestored is true when the server answered 200 OK,
+	// meaning the object was already restored and only its expiry date is updated.
+	// Otherwise the restoration has been accepted (202 Accepted).
+	AlreadyRestored bool
+
+	RequestCharged    *string
+	RestoreOutputPath *string
+}
+
+func (output *RestoreObjectOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	switch resp.StatusCode() {
+	case fasthttp.StatusOK:
+		output.AlreadyRestored = true
+	case fasthttp.StatusAccepted:
+		output.AlreadyRestored = false
+	default:
+		return NewServerSideError(resp)
+	}
+
+	output.RequestCharged = extractHeader(&resp.Header, HeaderXAmzRequestCharged)
+	output.RestoreOutputPath = extractHeader(&resp.Header, HeaderXAmzRestoreOutputPath)
+
+	return nil
+}
+
+// RestoreObject restores a temporary copy of an archived object,
+// use [types.ParseRestore] on the Restore output of [Client.HeadObject] to follow the restoration.
+func (c *Client) RestoreObject(ctx context.Context, input *RestoreObjectInput, optFns ...func(*Options)) (*RestoreObjectOutput, *Metadata, error) {
+	return PerformCall[*RestoreObjectInput, *RestoreObjectOutput](ctx, c, input, optFns...)
+}
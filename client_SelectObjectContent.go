@@ -0,0 +1,202 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/s3hobby/client/pkg/eventstream"
+	"github.com/s3hobby/client/types"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ RequiredBucketKeyInterface = (*SelectObjectContentInput)(nil)
+
+// errUnknownSelectEvent is returned for event types introduced after this client.
+var errUnknownSelectEvent = errors.New("unknown event type")
+
+type SelectObjectContentInput struct {
+	// Bucket is mandatory
+	Bucket string
+
+	// Key is mandatory
+	Key string
+
+	SSECustomerAlgorithm *string
+	SSECustomerKey       *string
+	SSECustomerKeyMD5    *string
+	ExpectedBucketOwner  *string
+
+	SelectObjectContentRequest types.SelectObjectContentRequest
+}
+
+func (input *SelectObjectContentInput) GetBucket() string {
+	return input.Bucket
+}
+
+func (input *SelectObjectContentInput) GetKey() string {
+	return input.Key
+}
+
+func (input *SelectObjectContentInput) MarshalHTTP(req *fasthttp.Request) error {
+	req.Header.SetMethod(fasthttp.MethodPost)
+
+	args := req.URI().QueryArgs()
+	args.SetNoValue(QuerySelect)
+	args.Set(QuerySelectType, "2")
+
+	if err := setXMLBody(req, &input.SelectObjectContentRequest); err != nil {
+		return err
+	}
+
+	setHeader(&req.Header, HeaderXAmzSSECustomerAlgorithm, input.SSECustomerAlgorithm)
+	setHeader(&req.Header, HeaderXAmzSSECustomerKey, input.SSECustomerKey)
+	setHeader(&req.Header, HeaderXAmzSSECustomerKeyMD5, input.SSECustomerKeyMD5)
+	setHeader(&req.Header, HeaderXAmzExpectedBucketOwner, input.ExpectedBucketOwner)
+
+	return nil
+}
+
+type SelectObjectContentOutput struct {
+	statusCode int
+	requestID  string
+	body       []byte
+	bodyStream io.Reader
+	closeBody  func() error
+}
+
+func (output *SelectObjectContentOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return NewServerSideError(resp)
+	}
+
+	output.statusCode = resp.StatusCode()
+	output.requestID = string(resp.Header.Peek(HeaderXAmzRequestID))
+
+	// The response is owned by the call, the events are decoded from it without a copy
+	if resp.IsBodyStream() {
+		output.bodyStream = resp.BodyStream()
+		output.closeBody = resp.CloseBodyStream
+	} else {
+		output.body = resp.Body()
+	}
+
+	return nil
+}
+
+// Events decodes the event stream of the response.
+// The iteration stops after the first error: error events sent by the server
+// are returned as [*ServerSideError], a stream without [types.EndEvent] is an error.
+// A streamed response body, see [fasthttp.Client.StreamResponseBody], is decoded
+// as it is received and closed at the end of the iteration: it can only be iterated once,
+// the next iterations only yield an error.
+func (output *SelectObjectContentOutput) Events() iter.Seq2[types.SelectObjectContentEvent, error] {
+	return func(yield func(types.SelectObjectContentEvent, error) bool) {
+		var body io.Reader = bytes.NewReader(output.body)
+		if output.bodyStream != nil {
+			if output.closeBody == nil {
+				yield(nil, errors.New("SelectObjectContent: the streamed events have already been iterated or closed"))
+				return
+			}
+
+			body = output.bodyStream
+			defer output.Close()
+		}
+
+		decoder := eventstream.NewDecoder(body)
+
+		for {
+			msg, err := decoder.Decode()
+			if errors.Is(err, io.EOF) {
+				yield(nil, errors.New("SelectObjectContent: event stream ended before the End event"))
+				return
+			}
+
+			if err != nil {
+				yield(nil, fmt.Errorf("SelectObjectContent: %w", err))
+				return
+			}
+
+			event, err := output.decodeEvent(msg)
+			if errors.Is(err, errUnknownSelectEvent) {
+				continue
+			}
+
+			if !yield(event, err) || err != nil {
+				return
+			}
+
+			if _, isEnd := event.(*types.EndEvent); isEnd {
+				return
+			}
+		}
+	}
+}
+
+// Close closes the streamed response body, if any, when [SelectObjectContentOutput.Events]
+// is not iterated. It can be called several times.
+func (output *SelectObjectContentOutput) Close() error {
+	if output.closeBody == nil {
+		return nil
+	}
+
+	closeBody := output.closeBody
+	output.closeBody = nil
+	return closeBody()
+}
+
+func (output *SelectObjectContentOutput) decodeEvent(msg *eventstream.Message) (types.SelectObjectContentEvent, error) {
+	switch messageType := msg.Headers.GetString(":message-type"); messageType {
+	case "event":
+	case "error", "exception":
+		return nil, &ServerSideError{
+			Code:       msg.Headers.GetString(":error-code"),
+			Message:    msg.Headers.GetString(":error-message"),
+			RequestID:  output.requestID,
+			StatusCode: output.statusCode,
+		}
+	default:
+		return nil, fmt.Errorf("SelectObjectContent: unexpected message type: %q", messageType)
+	}
+
+	switch eventType := msg.Headers.GetString(":event-type"); eventType {
+	case "Records":
+		return &types.RecordsEvent{Payload: msg.Payload}, nil
+
+	case "Stats":
+		var payload struct {
+			Details types.Stats
+		}
+		if err := xml.Unmarshal(msg.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("SelectObjectContent: cannot parse Stats event: %w", err)
+		}
+		return &types.StatsEvent{Details: &payload.Details}, nil
+
+	case "Progress":
+		var payload struct {
+			Details types.Progress
+		}
+		if err := xml.Unmarshal(msg.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("SelectObjectContent: cannot parse Progress event: %w", err)
+		}
+		return &types.ProgressEvent{Details: &payload.Details}, nil
+
+	case "Cont":
+		return &types.ContinuationEvent{}, nil
+
+	case "End":
+		return &types.EndEvent{}, nil
+
+	default:
+		return nil, errUnknownSelectEvent
+	}
+}
+
+func (c *Client) SelectObjectContent(ctx context.Context, input *SelectObjectContentInput, optFns ...func(*Options)) (*SelectObjectContentOutput, *Metadata, error) {
+	return PerformCall[*SelectObjectContentInput, *SelectObjectContentOutput](ctx, c, input, optFns...)
+}
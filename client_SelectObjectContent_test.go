@@ -0,0 +1,171 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/eventstream"
+	"github.com/s3hobby/client/pkg/fasthttptesting"
+	"github.com/s3hobby/client/pkg/signer"
+	"github.com/s3hobby/client/pkg/utils"
+	"github.com/s3hobby/client/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func newSelectObjectContentOutput(t *testing.T, stream bool, messages []*eventstream.Message) *SelectObjectContentOutput {
+	var body bytes.Buffer
+	for _, msg := range messages {
+		require.NoError(t, eventstream.Encode(&body, msg))
+	}
+
+	srv := fasthttptesting.NewInmemoryTester(func(ctx *fasthttp.RequestCtx) {
+		assert.Equal(t, "http://bucket.s3.dev-1.example.com/key.csv?select&select-type=2", ctx.URI().String())
+		assert.Equal(t, fasthttp.MethodPost, string(ctx.Method()))
+
+		ctx.Response.Header.Set(HeaderXAmzRequestID, "request-id")
+		ctx.Response.SetBody(body.Bytes())
+	})
+	t.Cleanup(srv.Close)
+
+	httpClient := srv.Client()
+	httpClient.StreamResponseBody = stream
+
+	c, err := New(&Options{
+		SiginingRegion: "dev-1",
+		EndpointHost:   "s3.dev-1.example.com",
+		Signer:         signer.NewAnonymousSigner(),
+		HTTPClient:     httpClient,
+	})
+	require.NoError(t, err)
+
+	out, _, err := c.SelectObjectContent(t.Context(), &SelectObjectContentInput{
+		Bucket: "bucket",
+		Key:    "key.csv",
+	})
+	require.NoError(t, err)
+
+	return out
+}
+
+func testSelectObjectContent(t *testing.T, stream bool, messages []*eventstream.Message) ([]types.SelectObjectContentEvent, error) {
+	return collectSelectObjectContentEvents(newSelectObjectContentOutput(t, stream, messages))
+}
+
+func collectSelectObjectContentEvents(out *SelectObjectContentOutput) ([]types.SelectObjectContentEvent, error) {
+	var events []types.SelectObjectContentEvent
+	for event, err := range out.Events() {
+		if err != nil {
+			return events, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func eventMessage(eventType string, payload string) *eventstream.Message {
+	return &eventstream.Message{
+		Headers: eventstream.Headers{
+			{Name: ":message-type", Value: "event"},
+			{Name: ":event-type", Value: eventType},
+		},
+		Payload: []byte(payload),
+	}
+}
+
+func TestSelectObjectContent(t *testing.T) {
+	for _, stream := range []bool{false, true} {
+		t.Run(fmt.Sprintf("ok (stream=%t)", stream), func(t *testing.T) {
+			events, err := testSelectObjectContent(t, stream, []*eventstream.Message{
+				eventMessage("Records", "a,b\n"),
+				eventMessage("Cont", ""),
+				eventMessage("Unknown", ""),
+				eventMessage("Progress", "<Progress><Details><BytesScanned>1</BytesScanned><BytesProcessed>2</BytesProcessed><BytesReturned>3</BytesReturned></Details></Progress>"),
+				eventMessage("Stats", "<Stats><Details><BytesScanned>4</BytesScanned><BytesProcessed>5</BytesProcessed><BytesReturned>6</BytesReturned></Details></Stats>"),
+				eventMessage("End", ""),
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, []types.SelectObjectContentEvent{
+				&types.RecordsEvent{Payload: []byte("a,b\n")},
+				&types.ContinuationEvent{},
+				&types.ProgressEvent{Details: &types.Progress{BytesScanned: utils.ToPtr("1"), BytesProcessed: utils.ToPtr("2"), BytesReturned: utils.ToPtr("3")}},
+				&types.StatsEvent{Details: &types.Stats{BytesScanned: utils.ToPtr("4"), BytesProcessed: utils.ToPtr("5"), BytesReturned: utils.ToPtr("6")}},
+				&types.EndEvent{},
+			}, events)
+		})
+	}
+
+	t.Run("error event", func(t *testing.T) {
+		events, err := testSelectObjectContent(t, false, []*eventstream.Message{
+			eventMessage("Records", "a,b\n"),
+			{
+				Headers: eventstream.Headers{
+					{Name: ":message-type", Value: "error"},
+					{Name: ":error-code", Value: "InternalError"},
+					{Name: ":error-message", Value: "something went wrong"},
+				},
+			},
+		})
+
+		require.Len(t, events, 1)
+
+		var sse *ServerSideError
+		require.ErrorAs(t, err, &sse)
+		require.Equal(t, &ServerSideError{
+			Code:       "InternalError",
+			Message:    "something went wrong",
+			RequestID:  "request-id",
+			StatusCode: fasthttp.StatusOK,
+		}, sse)
+	})
+
+	t.Run("missing end", func(t *testing.T) {
+		events, err := testSelectObjectContent(t, false, []*eventstream.Message{
+			eventMessage("Records", "a,b\n"),
+		})
+
+		require.Len(t, events, 1)
+		require.EqualError(t, err, "SelectObjectContent: event stream ended before the End event")
+	})
+
+	t.Run("events iterated twice", func(t *testing.T) {
+		messages := []*eventstream.Message{eventMessage("Records", "a,b\n"), eventMessage("End", "")}
+
+		out := newSelectObjectContentOutput(t, false, messages)
+		for range 2 {
+			events, err := collectSelectObjectContentEvents(out)
+			require.NoError(t, err)
+			require.Len(t, events, 2)
+		}
+
+		out = newSelectObjectContentOutput(t, true, messages)
+		events, err := collectSelectObjectContentEvents(out)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+
+		events, err = collectSelectObjectContentEvents(out)
+		require.EqualError(t, err, "SelectObjectContent: the streamed events have already been iterated or closed")
+		require.Empty(t, events)
+		require.NoError(t, out.Close())
+	})
+
+	t.Run("close without events", func(t *testing.T) {
+		for _, stream := range []bool{false, true} {
+			out := newSelectObjectContentOutput(t, stream, []*eventstream.Message{eventMessage("End", "")})
+			require.NoError(t, out.Close())
+			require.NoError(t, out.Close())
+
+			_, err := collectSelectObjectContentEvents(out)
+			if stream {
+				require.EqualError(t, err, "SelectObjectContent: the streamed events have already been iterated or closed")
+			} else {
+				require.NoError(t, err)
+			}
+		}
+	})
+}
@@ -64,6 +64,33 @@ func (input *mandatoryKeyInput) GetKey() string {
 	return input.Key
 }
 
+var _ RequiredIDInterface = (*mandatoryIDInput)(nil)
+
+type mandatoryIDInput struct {
+	mandatoryBucketInput
+	ID string
+}
+
+func (input *mandatoryIDInput) GetID() string {
+	return input.ID
+}
+
+// newTestClient returns an anonymous client of an in-memory server serving handler.
+func newTestClient(t *testing.T, handler fasthttp.RequestHandler) *Client {
+	srv := fasthttptesting.NewInmemoryTester(handler)
+	t.Cleanup(srv.Close)
+
+	c, err := New(&Options{
+		SiginingRegion: "dev-1",
+		EndpointHost:   "s3.dev-1.example.com",
+		Signer:         signer.NewAnonymousSigner(),
+		HTTPClient:     srv.Client(),
+	})
+	require.NoError(t, err)
+
+	return c
+}
+
 func testHandleCall_ok[Input HTTPRequestMarshaler](t *testing.T, apiIn Input, expectedURI string) {
 	expectedOut := &noMandatoryOutput{
 		OneOutput: uuid.NewString(),
@@ -192,4 +219,38 @@ func Test_handleCall(t *testing.T) {
 			testHandleCall_ko(t, apiIn, errors.New("bucket is mandatory"))
 		})
 	})
+	t.Run("with id", func(t *testing.T) {
+		t.Run("ok", func(t *testing.T) {
+			apiIn := &mandatoryIDInput{
+				mandatoryBucketInput: mandatoryBucketInput{
+					Bucket: uuid.NewString(),
+				},
+				ID: uuid.NewString(),
+			}
+
+			testHandleCall_ok(
+				t,
+				apiIn,
+				"http://"+apiIn.Bucket+".s3.dev-1.example.com/?query-value=",
+			)
+		})
+
+		t.Run("missing id", func(t *testing.T) {
+			apiIn := &mandatoryIDInput{
+				mandatoryBucketInput: mandatoryBucketInput{
+					Bucket: uuid.NewString(),
+				},
+			}
+
+			testHandleCall_ko(t, apiIn, errors.New("id is mandatory"))
+		})
+
+		t.Run("missing bucket", func(t *testing.T) {
+			apiIn := &mandatoryIDInput{
+				ID: uuid.NewString(),
+			}
+
+			testHandleCall_ko(t, apiIn, errors.New("bucket is mandatory"))
+		})
+	})
 }
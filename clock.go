@@ -0,0 +1,96 @@
+package client
+
+import (
+	"encoding/xml"
+	"sync"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// clockSkewThreshold is the minimal offset between the local and the server clocks to correct.
+// Below, the measured offset is mostly the latency and the one second resolution of the Date header.
+const clockSkewThreshold = time.Minute
+
+// requestTimeTooSkewedError holds the extra fields of the RequestTimeTooSkewed error.
+type requestTimeTooSkewedError struct {
+	ServerTime string `xml:"ServerTime"`
+}
+
+// clockOffsets tracks, per endpoint host, the offset to add to the local clock to get the server time.
+type clockOffsets struct {
+	offsets sync.Map // map[string]time.Duration
+}
+
+// Now returns the time of the server serving host.
+func (c *clockOffsets) Now(host string) time.Time {
+	now := time.Now()
+
+	if offset, found := c.offsets.Load(host); found {
+		if offset, ok := offset.(time.Duration); ok {
+			return now.Add(offset)
+		}
+	}
+
+	return now
+}
+
+// update saves the offset between serverTime and the local time now,
+// and reports whether the correction applied to the requests has changed.
+func (c *clockOffsets) update(host string, serverTime, now time.Time) bool {
+	offset := serverTime.Sub(now)
+	if offset.Abs() < clockSkewThreshold {
+		offset = 0
+	}
+
+	var previous time.Duration
+	if value, found := c.offsets.Load(host); found {
+		if stored, ok := value.(time.Duration); ok {
+			previous = stored
+		}
+	}
+
+	if offset == 0 {
+		c.offsets.Delete(host)
+	} else {
+		c.offsets.Store(host, offset)
+	}
+
+	return (offset - previous).Abs() >= clockSkewThreshold
+}
+
+// observe learns the server time from the response Date header,
+// and reports whether the correction has changed.
+func (c *clockOffsets) observe(host string, resp *fasthttp.Response, now time.Time) bool {
+	date := resp.Header.Peek(HeaderDate)
+	if len(date) == 0 {
+		return false
+	}
+
+	serverTime, err := fasthttp.ParseHTTPDate(date)
+	if err != nil {
+		return false
+	}
+
+	return c.update(host, serverTime, now)
+}
+
+// observeSkewError learns the server time from the ServerTime of the error,
+// more precise than the Date header, and reports whether the correction has changed.
+func (c *clockOffsets) observeSkewError(host string, err *ServerSideError, now time.Time) bool {
+	if err.Response == nil {
+		return false
+	}
+
+	var details requestTimeTooSkewedError
+	if xml.Unmarshal(err.Response.Body(), &details) != nil || details.ServerTime == "" {
+		return false
+	}
+
+	serverTime, parseErr := time.Parse(time.RFC3339, details.ServerTime)
+	if parseErr != nil {
+		return false
+	}
+
+	return c.update(host, serverTime, now)
+}
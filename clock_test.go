@@ -0,0 +1,156 @@
+package client
+
+import (
+	"bufio"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/s3hobby/client/pkg/signer"
+	v4 "github.com/s3hobby/client/pkg/signer/v4"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+type clockSkewOutput struct{}
+
+func (*clockSkewOutput) UnmarshalHTTP(resp *fasthttp.Response) error {
+	if resp.StatusCode() != fasthttp.StatusNoContent {
+		return NewServerSideError(resp)
+	}
+
+	return nil
+}
+
+// skewedServer answers as S3 does with a clock skewed from the local one.
+// fasthttp servers cannot send their own Date header, hence the raw responses.
+type skewedServer struct {
+	t             *testing.T
+	skew          time.Duration
+	withDate      bool
+	withErrorTime bool
+	alwaysReject  bool
+	calls         int
+}
+
+func (s *skewedServer) Do(req *fasthttp.Request, resp *fasthttp.Response) error {
+	s.calls++
+
+	serverTime := time.Now().Add(s.skew).UTC()
+
+	requestTime, err := time.Parse(v4.FormatXAmzDate, string(req.Header.Peek(v4.HeaderXAmzDate)))
+	assert.NoError(s.t, err)
+
+	status := "204 No Content"
+	var body string
+
+	if s.alwaysReject || serverTime.Sub(requestTime).Abs() > 15*time.Minute {
+		status = "403 Forbidden"
+		body = "<Error><Code>RequestTimeTooSkewed</Code>"
+		if s.withErrorTime {
+			body += "<ServerTime>" + serverTime.Format(time.RFC3339) + "</ServerTime>"
+		}
+		body += "</Error>"
+	}
+
+	raw := "HTTP/1.1 " + status + "\r\n"
+	if s.withDate {
+		raw += "Date: " + serverTime.Format(http.TimeFormat) + "\r\n"
+	}
+	raw += "Content-Length: " + strconv.Itoa(len(body)) + "\r\n\r\n" + body
+
+	return resp.Read(bufio.NewReader(strings.NewReader(raw)))
+}
+
+func TestClockSkewCorrection(t *testing.T) {
+	testCases := []struct {
+		name          string
+		server        *skewedServer
+		expectedCalls []int
+		expectedError error
+	}{
+		{
+			name:          "no skew",
+			server:        &skewedServer{withDate: true, withErrorTime: true},
+			expectedCalls: []int{1, 1},
+		},
+		{
+			name:          "from ServerTime",
+			server:        &skewedServer{skew: time.Hour, withErrorTime: true},
+			expectedCalls: []int{2, 1},
+		},
+		{
+			name:          "from Date",
+			server:        &skewedServer{skew: -time.Hour, withDate: true},
+			expectedCalls: []int{2, 1},
+		},
+		{
+			name:          "from ServerTime and Date",
+			server:        &skewedServer{skew: time.Hour, withDate: true, withErrorTime: true},
+			expectedCalls: []int{2, 1},
+		},
+		{
+			name:          "Date within the threshold",
+			server:        &skewedServer{withDate: true, alwaysReject: true},
+			expectedCalls: []int{1, 1},
+			expectedError: ErrRequestTimeTooSkewed,
+		},
+		{
+			name:          "nothing to learn from",
+			server:        &skewedServer{skew: time.Hour},
+			expectedCalls: []int{1, 1},
+			expectedError: ErrRequestTimeTooSkewed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.server.t = t
+
+			c, err := New(&Options{
+				SiginingRegion:   "dev-1",
+				EndpointHost:     "s3.dev-1.example.com",
+				Signer:           v4.NewHeaderSigner(false, false),
+				Credentials:      &signer.Credentials{AccessKeyID: "AK", SecretAccessKey: "SK"},
+				HTTPClient:       tc.server,
+				EndpointResolver: DefaultEndpointResolver,
+			})
+			require.NoError(t, err)
+
+			for _, expectedCalls := range tc.expectedCalls {
+				tc.server.calls = 0
+
+				_, _, err = PerformCall[*noMandatoryInput, *clockSkewOutput](t.Context(), c, &noMandatoryInput{})
+				if tc.expectedError != nil {
+					require.ErrorIs(t, err, tc.expectedError)
+				} else {
+					require.NoError(t, err)
+				}
+				require.Equal(t, expectedCalls, tc.server.calls)
+			}
+		})
+	}
+}
+
+func TestClockOffsets(t *testing.T) {
+	var offsets clockOffsets
+
+	now := time.Now()
+
+	require.False(t, offsets.update("s3.example.com", now.Add(30*time.Second), now))
+	require.WithinDuration(t, time.Now(), offsets.Now("s3.example.com"), time.Second)
+
+	require.True(t, offsets.update("s3.example.com", now.Add(-time.Hour), now))
+	require.WithinDuration(t, time.Now().Add(-time.Hour), offsets.Now("s3.example.com"), time.Second)
+	require.WithinDuration(t, time.Now(), offsets.Now("other.example.com"), time.Second)
+
+	require.False(t, offsets.update("s3.example.com", now.Add(-time.Hour-time.Second), now))
+	require.WithinDuration(t, time.Now().Add(-time.Hour-time.Second), offsets.Now("s3.example.com"), time.Second)
+
+	require.True(t, offsets.update("s3.example.com", now, now))
+	require.WithinDuration(t, time.Now(), offsets.Now("s3.example.com"), time.Second)
+}
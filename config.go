@@ -0,0 +1,705 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/s3hobby/client/pkg/signer"
+	v4 "github.com/s3hobby/client/pkg/signer/v4"
+)
+
+// DefaultProfile is the profile used when neither ConfigSources.Profile nor AWS_PROFILE is set.
+const DefaultProfile = "default"
+
+const envAccessKeyID = "AWS_ACCESS_KEY_ID"
+const envConfigFile = "AWS_CONFIG_FILE"
+const envContainerAuthorizationToken = "AWS_CONTAINER_AUTHORIZATION_TOKEN"
+const envContainerAuthorizationTokenFile = "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"
+const envContainerCredentialsFullURI = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
+const envContainerCredentialsRelativeURI = "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"
+const envDefaultRegion = "AWS_DEFAULT_REGION"
+const envEC2MetadataDisabled = "AWS_EC2_METADATA_DISABLED"
+const envEC2MetadataServiceEndpoint = "AWS_EC2_METADATA_SERVICE_ENDPOINT"
+const envEndpointURL = "AWS_ENDPOINT_URL"
+const envIgnoreConfiguredEndpointURLs = "AWS_IGNORE_CONFIGURED_ENDPOINT_URLS"
+const envProfile = "AWS_PROFILE"
+const envRegion = "AWS_REGION"
+const envRoleARN = "AWS_ROLE_ARN"
+const envRoleSessionName = "AWS_ROLE_SESSION_NAME"
+const envSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+const envSessionToken = "AWS_SESSION_TOKEN"
+const envSharedCredentialsFile = "AWS_SHARED_CREDENTIALS_FILE"
+const envWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+const credentialSourceEc2InstanceMetadata = "Ec2InstanceMetadata"
+const credentialSourceEcsContainer = "EcsContainer"
+const credentialSourceEnvironment = "Environment"
+
+// containerCredentialsAgents are the link-local addresses of the ECS and EKS credentials agents.
+var containerCredentialsAgents = []net.IP{
+	net.ParseIP("169.254.170.2"),
+	net.ParseIP("169.254.170.23"),
+	net.ParseIP("fd00:ec2::23"),
+}
+
+// ConfigSources locates the configuration read by [LoadOptions].
+type ConfigSources struct {
+	// Profile defaults to AWS_PROFILE, then [DefaultProfile].
+	Profile string
+
+	// ConfigFile defaults to AWS_CONFIG_FILE, then ~/.aws/config.
+	ConfigFile string
+
+	// CredentialsFile defaults to AWS_SHARED_CREDENTIALS_FILE, then ~/.aws/credentials.
+	CredentialsFile string
+
+	// LookupEnv defaults to [os.LookupEnv].
+	LookupEnv func(key string) (string, bool)
+}
+
+// LoadOptions builds [Options] from the AWS_* environment variables and the
+// shared config and credentials files, then applies optFns.
+//
+// For each setting, the first defined source wins:
+//   - optFns;
+//   - the environment variables;
+//   - the profile of the credentials file, for the credentials;
+//   - the profile of the config file.
+//
+// The endpoint is resolved from AWS_ENDPOINT_URL_S3, AWS_ENDPOINT_URL, the s3
+// endpoint_url of the services section referenced by the profile, then the
+// endpoint_url of the profile. It defaults to the AWS S3 regional endpoint.
+// The STS endpoint assuming the roles is resolved the same way.
+// The signer defaults to SigV4, signing the payload when not using HTTPS,
+// or to the anonymous signer without credentials.
+//
+// The credentials are resolved from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY,
+// AWS_WEB_IDENTITY_TOKEN_FILE and AWS_ROLE_ARN, then the profile: its static keys,
+// credential_process or role_arn, following the source_profile chain.
+// They fall back to the container credentials of AWS_CONTAINER_CREDENTIALS_RELATIVE_URI
+// or AWS_CONTAINER_CREDENTIALS_FULL_URI, then to the role of the EC2 instance unless
+// AWS_EC2_METADATA_DISABLED is true, the requests being anonymous.
+// Unless static, they are retrieved by a [CredentialsCache] set as CredentialsProvider.
+func LoadOptions(sources *ConfigSources, optFns ...func(*Options)) (*Options, error) {
+	loader, err := newConfigLoader(sources)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := loader.profile()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &Options{}
+
+	opts.SiginingRegion = loader.firstEnv(envRegion, envDefaultRegion)
+	if opts.SiginingRegion == "" {
+		opts.SiginingRegion = profile.get("region")
+	}
+
+	if opts.EndpointHost, opts.UseSSL, err = loader.resolveEndpoint(profile, v4.DefaultService); err != nil {
+		return nil, err
+	}
+
+	switch style := profile.s3["addressing_style"]; style {
+	case "", "auto", "virtual":
+	case "path":
+		opts.UsePathStyle = true
+	default:
+		return nil, fmt.Errorf("profile %q: invalid s3 addressing_style %q, expected auto, virtual or path", profile.name, style)
+	}
+
+	credentials, err := loader.credentials(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	sts := &STSEndpoint{}
+	if credentials != nil && credentials.static == nil {
+		if sts.Host, sts.UseSSL, err = loader.resolveEndpoint(profile, stsService); err != nil {
+			return nil, err
+		}
+	} else if credentials != nil {
+		opts.Credentials = credentials.static
+	}
+
+	for _, fn := range optFns {
+		fn(opts)
+	}
+
+	if opts.SiginingRegion == "" {
+		return nil, fmt.Errorf("no region configured: set %s or the region of profile %q", envRegion, profile.name)
+	}
+
+	if credentials != nil && opts.Credentials == nil && opts.CredentialsProvider == nil {
+		sts.Region = opts.SiginingRegion
+		sts.HTTPClient = opts.HTTPClient
+
+		provider, err := loader.credentialsProvider(credentials, sts)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.CredentialsProvider = NewCredentialsCache(provider)
+	}
+
+	if opts.EndpointHost == "" {
+		opts.EndpointHost = "s3." + opts.SiginingRegion + ".amazonaws.com"
+		opts.UseSSL = true
+	}
+
+	switch {
+	case opts.Signer != nil:
+	case opts.Credentials == nil && opts.CredentialsProvider == nil:
+		// No credentials to sign with
+		opts.Signer = signer.NewAnonymousSigner()
+	default:
+		opts.Signer = v4.NewHeaderSigner(!opts.UseSSL, false)
+	}
+
+	return opts, nil
+}
+
+type configLoader struct {
+	lookupEnv func(key string) (string, bool)
+
+	profileName     string
+	explicitProfile bool
+
+	configPath      string
+	configFile      configFile
+	credentialsPath string
+	credentialsFile configFile
+}
+
+func newConfigLoader(sources *ConfigSources) (*configLoader, error) {
+	if sources == nil {
+		sources = &ConfigSources{}
+	}
+
+	l := &configLoader{
+		lookupEnv:       sources.LookupEnv,
+		profileName:     sources.Profile,
+		configPath:      sources.ConfigFile,
+		credentialsPath: sources.CredentialsFile,
+	}
+
+	if l.lookupEnv == nil {
+		l.lookupEnv = os.LookupEnv
+	}
+
+	if l.profileName == "" {
+		l.profileName = l.firstEnv(envProfile)
+	}
+	l.explicitProfile = l.profileName != ""
+	if !l.explicitProfile {
+		l.profileName = DefaultProfile
+	}
+
+	var err error
+
+	if l.configPath, err = l.filePath(l.configPath, envConfigFile, "config"); err != nil {
+		return nil, err
+	}
+
+	if l.credentialsPath, err = l.filePath(l.credentialsPath, envSharedCredentialsFile, "credentials"); err != nil {
+		return nil, err
+	}
+
+	if l.configFile, err = loadConfigFile(l.configPath); err != nil {
+		return nil, fmt.Errorf("cannot load config file: %w", err)
+	}
+
+	if l.credentialsFile, err = loadConfigFile(l.credentialsPath); err != nil {
+		return nil, fmt.Errorf("cannot load credentials file: %w", err)
+	}
+
+	return l, nil
+}
+
+func (l *configLoader) firstEnv(keys ...string) string {
+	for _, key := range keys {
+		if value, found := l.lookupEnv(key); found && value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// filePath returns the path of a shared file, expanding the leading ~.
+func (l *configLoader) filePath(path, envKey, defaultName string) (string, error) {
+	if path == "" {
+		path = l.firstEnv(envKey)
+	}
+
+	if path == "" {
+		path = filepath.Join("~", ".aws", defaultName)
+	}
+
+	if path == "~" || strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("cannot locate the %s file: %w", defaultName, err)
+		}
+
+		path = filepath.Join(home, path[1:])
+	}
+
+	return path, nil
+}
+
+// configProfile is a profile merged from the config and credentials files.
+type configProfile struct {
+	name       string
+	properties map[string]string
+	s3         map[string]string
+}
+
+func (p *configProfile) get(key string) string {
+	return p.properties[key]
+}
+
+// profile returns the selected profile, an explicitly selected profile must exist.
+func (l *configLoader) profile() (*configProfile, error) {
+	profile, found := l.lookupProfile(l.profileName)
+	if !found && l.explicitProfile {
+		return nil, fmt.Errorf("profile %q not found in %s nor %s", l.profileName, l.configPath, l.credentialsPath)
+	}
+
+	return profile, nil
+}
+
+// lookupProfile merges the sections of the profile, the credentials file taking precedence.
+func (l *configLoader) lookupProfile(name string) (*configProfile, bool) {
+	ret := &configProfile{
+		name:       name,
+		properties: map[string]string{},
+		s3:         map[string]string{},
+	}
+
+	sections := []*configSection{l.configFile["profile "+name]}
+	if name == DefaultProfile {
+		sections = []*configSection{l.configFile[DefaultProfile], l.configFile["profile "+DefaultProfile]}
+	}
+	sections = append(sections, l.credentialsFile[name])
+
+	found := false
+	for _, section := range sections {
+		if section == nil {
+			continue
+		}
+		found = true
+
+		for key, value := range section.properties {
+			ret.properties[key] = value
+		}
+
+		for key, value := range section.subsections["s3"] {
+			ret.s3[key] = value
+		}
+	}
+
+	return ret, found
+}
+
+// resolveEndpoint returns the configured endpoint of the service, an empty host when none.
+func (l *configLoader) resolveEndpoint(profile *configProfile, service string) (host string, useSSL bool, err error) {
+	if ignore := l.firstEnv(envIgnoreConfiguredEndpointURLs); ignore != "" {
+		if ignored, err := strconv.ParseBool(ignore); err != nil {
+			return "", false, fmt.Errorf("invalid %s: %w", envIgnoreConfiguredEndpointURLs, err)
+		} else if ignored {
+			return "", false, nil
+		}
+	} else if ignore := profile.get("ignore_configured_endpoint_urls"); ignore != "" {
+		if ignored, err := strconv.ParseBool(ignore); err != nil {
+			return "", false, fmt.Errorf("profile %q: invalid ignore_configured_endpoint_urls: %w", profile.name, err)
+		} else if ignored {
+			return "", false, nil
+		}
+	}
+
+	source := envEndpointURL + "_" + strings.ToUpper(service)
+	endpointURL := l.firstEnv(source)
+
+	if endpointURL == "" {
+		source = envEndpointURL
+		endpointURL = l.firstEnv(envEndpointURL)
+	}
+
+	if endpointURL == "" {
+		if services := profile.get("services"); services != "" {
+			section, found := l.configFile["services "+services]
+			if !found {
+				return "", false, fmt.Errorf("profile %q: services section %q not found in %s", profile.name, services, l.configPath)
+			}
+
+			source = fmt.Sprintf("services %q", services)
+			endpointURL = section.subsections[service]["endpoint_url"]
+		}
+	}
+
+	if endpointURL == "" {
+		source = fmt.Sprintf("profile %q", profile.name)
+		endpointURL = profile.get("endpoint_url")
+	}
+
+	if endpointURL == "" {
+		return "", false, nil
+	}
+
+	u, err := url.Parse(endpointURL)
+	if err != nil {
+		return "", false, fmt.Errorf("%s: invalid endpoint_url: %w", source, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", false, fmt.Errorf("%s: endpoint_url %q must use http or https", source, endpointURL)
+	}
+
+	if u.Host == "" {
+		return "", false, fmt.Errorf("%s: endpoint_url %q has no host", source, endpointURL)
+	}
+
+	if u.Path != "" && u.Path != "/" {
+		return "", false, fmt.Errorf("%s: endpoint_url %q must not have a path", source, endpointURL)
+	}
+
+	return u.Host, u.Scheme == "https", nil
+}
+
+// profileCredentials describes how to get the credentials of a profile.
+type profileCredentials struct {
+	static            *signer.Credentials
+	credentialProcess string
+	credentialSource  string
+	role              *profileRole
+}
+
+// profileRole is the role to assume with the credentials of its source.
+type profileRole struct {
+	arn             string
+	sessionName     string
+	externalID      string
+	durationSeconds int
+
+	// Exactly one of the following is set
+	sourceProfile        *profileCredentials
+	credentialSource     string
+	webIdentityTokenFile string
+}
+
+// credentials resolves the credentials of the environment or the profile, nil when anonymous.
+func (l *configLoader) credentials(profile *configProfile) (*profileCredentials, error) {
+	static, err := l.envCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	// Unless they are the source of the role of the profile
+	if static != nil && (profile.get("role_arn") == "" || profile.get("credential_source") != credentialSourceEnvironment) {
+		return &profileCredentials{static: static}, nil
+	}
+
+	if tokenFile := l.firstEnv(envWebIdentityTokenFile); tokenFile != "" {
+		roleARN := l.firstEnv(envRoleARN)
+		if roleARN == "" {
+			return nil, fmt.Errorf("%s is set without %s", envWebIdentityTokenFile, envRoleARN)
+		}
+
+		return &profileCredentials{
+			role: &profileRole{
+				arn:                  roleARN,
+				sessionName:          l.firstEnv(envRoleSessionName),
+				webIdentityTokenFile: tokenFile,
+			},
+		}, nil
+	}
+
+	resolved, err := l.profileCredentials(profile, map[string]bool{})
+	if err != nil || resolved != nil {
+		return resolved, err
+	}
+
+	return l.defaultCredentials()
+}
+
+// defaultCredentials falls back to the container credentials, then to the instance metadata.
+func (l *configLoader) defaultCredentials() (*profileCredentials, error) {
+	if l.firstEnv(envContainerCredentialsRelativeURI, envContainerCredentialsFullURI) != "" {
+		return &profileCredentials{credentialSource: credentialSourceEcsContainer}, nil
+	}
+
+	if disabled := l.firstEnv(envEC2MetadataDisabled); disabled != "" {
+		if disabled, err := strconv.ParseBool(disabled); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", envEC2MetadataDisabled, err)
+		} else if disabled {
+			//nolint:nilnil // no credentials means anonymous requests
+			return nil, nil
+		}
+	}
+
+	return &profileCredentials{credentialSource: credentialSourceEc2InstanceMetadata}, nil
+}
+
+// envCredentials returns the static credentials of the environment, nil when not set.
+func (l *configLoader) envCredentials() (*signer.Credentials, error) {
+	accessKeyID := l.firstEnv(envAccessKeyID)
+	secretAccessKey := l.firstEnv(envSecretAccessKey)
+
+	switch {
+	case accessKeyID != "" && secretAccessKey != "":
+		return &signer.Credentials{
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+			SessionToken:    l.firstEnv(envSessionToken),
+		}, nil
+	case accessKeyID != "":
+		return nil, fmt.Errorf("%s is set without %s", envAccessKeyID, envSecretAccessKey)
+	case secretAccessKey != "":
+		return nil, fmt.Errorf("%s is set without %s", envSecretAccessKey, envAccessKeyID)
+	default:
+		//nolint:nilnil // the credentials are not set in the environment
+		return nil, nil
+	}
+}
+
+// credentialsProvider builds the provider of the resolved credentials, assuming the roles with sts.
+func (l *configLoader) credentialsProvider(resolved *profileCredentials, sts *STSEndpoint) (CredentialsProvider, error) {
+	switch {
+	case resolved.static != nil:
+		return &StaticCredentialsProvider{Credentials: resolved.static}, nil
+	case resolved.credentialProcess != "":
+		return NewProcessCredentialsProvider(resolved.credentialProcess), nil
+	case resolved.credentialSource != "":
+		return l.credentialSourceProvider(resolved.credentialSource, sts.HTTPClient)
+	}
+
+	role := resolved.role
+
+	if role.webIdentityTokenFile != "" {
+		return &WebIdentityRoleProvider{
+			STS:             *sts,
+			RoleARN:         role.arn,
+			RoleSessionName: role.sessionName,
+			TokenFile:       role.webIdentityTokenFile,
+			DurationSeconds: role.durationSeconds,
+		}, nil
+	}
+
+	var source CredentialsProvider
+	var err error
+
+	if role.credentialSource != "" {
+		source, err = l.credentialSourceProvider(role.credentialSource, sts.HTTPClient)
+	} else {
+		source, err = l.credentialsProvider(role.sourceProfile, sts)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("role %q: %w", role.arn, err)
+	}
+
+	return &AssumeRoleProvider{
+		STS:             *sts,
+		Source:          source,
+		RoleARN:         role.arn,
+		RoleSessionName: role.sessionName,
+		ExternalID:      role.externalID,
+		DurationSeconds: role.durationSeconds,
+	}, nil
+}
+
+// credentialSourceProvider builds the provider of a credential_source.
+func (l *configLoader) credentialSourceProvider(credentialSource string, httpClient HTTPClient) (CredentialsProvider, error) {
+	switch credentialSource {
+	case credentialSourceEnvironment:
+		static, err := l.envCredentials()
+		if err != nil {
+			return nil, err
+		}
+
+		if static == nil {
+			return nil, fmt.Errorf("credential_source %s: %s and %s are not set", credentialSource, envAccessKeyID, envSecretAccessKey)
+		}
+
+		return &StaticCredentialsProvider{Credentials: static}, nil
+	case credentialSourceEc2InstanceMetadata:
+		return &EC2RoleProvider{
+			Endpoint:   l.firstEnv(envEC2MetadataServiceEndpoint),
+			HTTPClient: httpClient,
+		}, nil
+	default:
+		return l.containerCredentialsProvider(httpClient)
+	}
+}
+
+// containerCredentialsProvider builds the provider of the container credentials endpoint.
+// A full URI must use HTTPS, or target the loopback interface or the ECS and EKS agents.
+func (l *configLoader) containerCredentialsProvider(httpClient HTTPClient) (CredentialsProvider, error) {
+	provider := &ContainerCredentialsProvider{
+		AuthorizationTokenFile: l.firstEnv(envContainerAuthorizationTokenFile),
+		AuthorizationToken:     l.firstEnv(envContainerAuthorizationToken),
+		HTTPClient:             httpClient,
+	}
+
+	if relativeURI := l.firstEnv(envContainerCredentialsRelativeURI); relativeURI != "" {
+		provider.URI = DefaultContainerCredentialsEndpoint + "/" + strings.TrimPrefix(relativeURI, "/")
+		return provider, nil
+	}
+
+	fullURI := l.firstEnv(envContainerCredentialsFullURI)
+	if fullURI == "" {
+		return nil, fmt.Errorf("credential_source %s: %s and %s are not set", credentialSourceEcsContainer, envContainerCredentialsRelativeURI, envContainerCredentialsFullURI)
+	}
+
+	u, err := url.Parse(fullURI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", envContainerCredentialsFullURI, err)
+	}
+
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && isAllowedContainerCredentialsHost(u.Hostname()):
+	default:
+		return nil, fmt.Errorf("%s %q must use https, or http on the loopback interface or the ECS and EKS agents", envContainerCredentialsFullURI, fullURI)
+	}
+
+	provider.URI = fullURI
+	return provider, nil
+}
+
+func isAllowedContainerCredentialsHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	return ip.IsLoopback() || slices.ContainsFunc(containerCredentialsAgents, ip.Equal)
+}
+
+// profileCredentials resolves the credentials of the profile, following the role chaining
+// references. visited detects the source_profile loops.
+func (l *configLoader) profileCredentials(profile *configProfile, visited map[string]bool) (*profileCredentials, error) {
+	visited[profile.name] = true
+
+	if roleARN := profile.get("role_arn"); roleARN != "" {
+		role, err := l.profileRole(profile, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		role.arn = roleARN
+		return &profileCredentials{role: role}, nil
+	}
+
+	if process := profile.get("credential_process"); process != "" {
+		return &profileCredentials{credentialProcess: process}, nil
+	}
+
+	return profileStaticCredentials(profile)
+}
+
+func profileStaticCredentials(profile *configProfile) (*profileCredentials, error) {
+	accessKeyID := profile.get("aws_access_key_id")
+	secretAccessKey := profile.get("aws_secret_access_key")
+
+	switch {
+	case accessKeyID != "" && secretAccessKey != "":
+		return &profileCredentials{
+			static: &signer.Credentials{
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: secretAccessKey,
+				SessionToken:    profile.get("aws_session_token"),
+			},
+		}, nil
+	case accessKeyID != "":
+		return nil, fmt.Errorf("profile %q: aws_access_key_id is set without aws_secret_access_key", profile.name)
+	case secretAccessKey != "":
+		return nil, fmt.Errorf("profile %q: aws_secret_access_key is set without aws_access_key_id", profile.name)
+	default:
+		//nolint:nilnil // no credentials means anonymous requests
+		return nil, nil
+	}
+}
+
+func (l *configLoader) profileRole(profile *configProfile, visited map[string]bool) (*profileRole, error) {
+	role := &profileRole{
+		sessionName:          profile.get("role_session_name"),
+		externalID:           profile.get("external_id"),
+		credentialSource:     profile.get("credential_source"),
+		webIdentityTokenFile: profile.get("web_identity_token_file"),
+	}
+
+	if duration := profile.get("duration_seconds"); duration != "" {
+		seconds, err := strconv.Atoi(duration)
+		if err != nil || seconds <= 0 {
+			return nil, fmt.Errorf("profile %q: invalid duration_seconds %q", profile.name, duration)
+		}
+
+		role.durationSeconds = seconds
+	}
+
+	sourceProfile := profile.get("source_profile")
+
+	nbSources := 0
+	for _, source := range []string{sourceProfile, role.credentialSource, role.webIdentityTokenFile} {
+		if source != "" {
+			nbSources++
+		}
+	}
+
+	switch {
+	case nbSources == 0:
+		return nil, fmt.Errorf("profile %q: role_arn requires source_profile, credential_source or web_identity_token_file", profile.name)
+	case nbSources > 1:
+		return nil, fmt.Errorf("profile %q: source_profile, credential_source and web_identity_token_file are mutually exclusive", profile.name)
+	}
+
+	switch role.credentialSource {
+	case "", credentialSourceEnvironment, credentialSourceEc2InstanceMetadata, credentialSourceEcsContainer:
+	default:
+		return nil, fmt.Errorf("profile %q: invalid credential_source %q, expected Environment, Ec2InstanceMetadata or EcsContainer", profile.name, role.credentialSource)
+	}
+
+	if sourceProfile == "" {
+		return role, nil
+	}
+
+	source, found := l.lookupProfile(sourceProfile)
+	if !found {
+		return nil, fmt.Errorf("profile %q: source_profile %q not found", profile.name, sourceProfile)
+	}
+
+	var err error
+
+	if sourceProfile == profile.name {
+		// A profile can assume a role with its own static credentials
+		role.sourceProfile, err = profileStaticCredentials(source)
+	} else if visited[sourceProfile] {
+		return nil, fmt.Errorf("profile %q: source_profile %q loops back in the role chain", profile.name, sourceProfile)
+	} else {
+		role.sourceProfile, err = l.profileCredentials(source, visited)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if role.sourceProfile == nil {
+		return nil, fmt.Errorf("profile %q: source_profile %q has no credentials", profile.name, sourceProfile)
+	}
+
+	return role, nil
+}
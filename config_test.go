@@ -0,0 +1,255 @@
+package client
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/fasthttptesting"
+	"github.com/s3hobby/client/pkg/signer"
+	v4 "github.com/s3hobby/client/pkg/signer/v4"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+const testConfigFile = `
+# comment
+[default]
+region = eu-west-1 ; inline comment
+s3 =
+  addressing_style = path
+
+[profile dev]
+region = us-east-1
+endpoint_url = http://localhost:9000
+aws_access_key_id = config-ak
+aws_secret_access_key = config-sk
+
+[profile shared]
+region = us-west-2
+services = local
+
+[services local]
+s3 =
+  endpoint_url = https://s3.local:8443/
+
+[profile role]
+role_arn = arn:aws:iam::123456789012:role/test
+source_profile = dev
+
+[profile loop-a]
+role_arn = arn:aws:iam::123456789012:role/a
+source_profile = loop-b
+
+[profile loop-b]
+role_arn = arn:aws:iam::123456789012:role/b
+source_profile = loop-a
+
+[profile ambiguous]
+role_arn = arn:aws:iam::123456789012:role/test
+source_profile = dev
+credential_source = Environment
+
+[profile orphan]
+role_arn = arn:aws:iam::123456789012:role/test
+
+[profile process]
+credential_process = /bin/creds ; echo
+  --profile dev
+`
+
+const testCredentialsFile = `
+[default]
+aws_access_key_id = default-ak
+aws_secret_access_key = default-sk
+
+[dev]
+aws_access_key_id = credentials-ak
+aws_secret_access_key = credentials-sk
+aws_session_token = credentials-token
+`
+
+func newTestConfigSources(t *testing.T, profile string, env map[string]string) *ConfigSources {
+	t.Helper()
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config")
+	credentialsPath := filepath.Join(dir, "credentials")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(testConfigFile), 0o600))
+	require.NoError(t, os.WriteFile(credentialsPath, []byte(testCredentialsFile), 0o600))
+
+	return &ConfigSources{
+		Profile:         profile,
+		ConfigFile:      configPath,
+		CredentialsFile: credentialsPath,
+		LookupEnv: func(key string) (string, bool) {
+			value, found := env[key]
+			return value, found
+		},
+	}
+}
+
+func TestParseConfigFile(t *testing.T) {
+	file, err := parseConfigFile(bufio.NewScanner(strings.NewReader(testConfigFile)))
+	require.NoError(t, err)
+
+	require.Equal(t, "eu-west-1", file["default"].properties["region"])
+	require.Equal(t, map[string]string{"addressing_style": "path"}, file["default"].subsections["s3"])
+	require.Equal(t, "https://s3.local:8443/", file["services local"].subsections["s3"]["endpoint_url"])
+	require.Equal(t, "/bin/creds ; echo --profile dev", file["profile process"].properties["credential_process"])
+
+	for _, invalid := range []string{
+		"region = eu-west-1",
+		"[default",
+		"[]",
+		"[default]\nregion",
+		"[default]\n= value",
+	} {
+		_, err := parseConfigFile(bufio.NewScanner(strings.NewReader(invalid)))
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestLoadOptions(t *testing.T) {
+	t.Run("default profile", func(t *testing.T) {
+		opts, err := LoadOptions(newTestConfigSources(t, "", nil))
+		require.NoError(t, err)
+
+		require.Equal(t, "eu-west-1", opts.SiginingRegion)
+		require.Equal(t, "s3.eu-west-1.amazonaws.com", opts.EndpointHost)
+		require.True(t, opts.UseSSL)
+		require.True(t, opts.UsePathStyle)
+		require.Equal(t, &signer.Credentials{AccessKeyID: "default-ak", SecretAccessKey: "default-sk"}, opts.Credentials)
+		require.IsType(t, &v4.HeaderSigner{}, opts.Signer)
+	})
+
+	t.Run("credentials file takes precedence", func(t *testing.T) {
+		opts, err := LoadOptions(newTestConfigSources(t, "dev", nil))
+		require.NoError(t, err)
+
+		require.Equal(t, "us-east-1", opts.SiginingRegion)
+		require.Equal(t, "localhost:9000", opts.EndpointHost)
+		require.False(t, opts.UseSSL)
+		require.False(t, opts.UsePathStyle)
+		require.Equal(t, &signer.Credentials{
+			AccessKeyID:     "credentials-ak",
+			SecretAccessKey: "credentials-sk",
+			SessionToken:    "credentials-token",
+		}, opts.Credentials)
+	})
+
+	t.Run("environment takes precedence", func(t *testing.T) {
+		opts, err := LoadOptions(newTestConfigSources(t, "", map[string]string{
+			"AWS_PROFILE":           "dev",
+			"AWS_REGION":            "ap-south-1",
+			"AWS_DEFAULT_REGION":    "ca-central-1",
+			"AWS_ENDPOINT_URL":      "http://generic:1234",
+			"AWS_ENDPOINT_URL_S3":   "https://specific",
+			"AWS_ACCESS_KEY_ID":     "env-ak",
+			"AWS_SECRET_ACCESS_KEY": "env-sk",
+		}))
+		require.NoError(t, err)
+
+		require.Equal(t, "ap-south-1", opts.SiginingRegion)
+		require.Equal(t, "specific", opts.EndpointHost)
+		require.True(t, opts.UseSSL)
+		require.Equal(t, &signer.Credentials{AccessKeyID: "env-ak", SecretAccessKey: "env-sk"}, opts.Credentials)
+	})
+
+	t.Run("option functions take precedence", func(t *testing.T) {
+		opts, err := LoadOptions(newTestConfigSources(t, "dev", nil), func(o *Options) {
+			o.SiginingRegion = "us-east-2"
+			o.EndpointHost = ""
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, "us-east-2", opts.SiginingRegion)
+		require.Equal(t, "s3.us-east-2.amazonaws.com", opts.EndpointHost)
+		require.True(t, opts.UseSSL)
+	})
+
+	t.Run("services section", func(t *testing.T) {
+		opts, err := LoadOptions(newTestConfigSources(t, "shared", nil))
+		require.NoError(t, err)
+
+		require.Equal(t, "s3.local:8443", opts.EndpointHost)
+		require.True(t, opts.UseSSL)
+	})
+
+	t.Run("ignore configured endpoint urls", func(t *testing.T) {
+		opts, err := LoadOptions(newTestConfigSources(t, "dev", map[string]string{
+			"AWS_IGNORE_CONFIGURED_ENDPOINT_URLS": "true",
+			"AWS_ENDPOINT_URL":                    "http://ignored",
+		}))
+		require.NoError(t, err)
+
+		require.Equal(t, "s3.us-east-1.amazonaws.com", opts.EndpointHost)
+	})
+
+	t.Run("missing files", func(t *testing.T) {
+		dir := t.TempDir()
+
+		opts, err := LoadOptions(&ConfigSources{
+			ConfigFile:      filepath.Join(dir, "config"),
+			CredentialsFile: filepath.Join(dir, "credentials"),
+			LookupEnv: func(key string) (string, bool) {
+				return "eu-central-1", key == "AWS_REGION"
+			},
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, "eu-central-1", opts.SiginingRegion)
+		require.Nil(t, opts.Credentials)
+	})
+
+	t.Run("anonymous requests", func(t *testing.T) {
+		srv := fasthttptesting.NewInmemoryTester(func(ctx *fasthttp.RequestCtx) {
+			assert.Empty(t, ctx.Request.Header.Peek("Authorization"))
+			ctx.SetStatusCode(fasthttp.StatusOK)
+		})
+		defer srv.Close()
+
+		opts, err := LoadOptions(newTestConfigSources(t, "shared", map[string]string{
+			"AWS_EC2_METADATA_DISABLED": "true",
+		}), func(o *Options) {
+			o.UseSSL = false
+			o.HTTPClient = srv.Client()
+		})
+		require.NoError(t, err)
+		require.Nil(t, opts.Credentials)
+		require.Nil(t, opts.CredentialsProvider)
+
+		c, err := New(opts)
+		require.NoError(t, err)
+
+		_, _, err = c.HeadBucket(t.Context(), &HeadBucketInput{Bucket: "bucket"})
+		require.NoError(t, err)
+	})
+
+	for _, tc := range []struct {
+		name    string
+		profile string
+		env     map[string]string
+		err     string
+	}{
+		{name: "unknown profile", profile: "unknown", err: `profile "unknown" not found`},
+		{name: "no region", profile: "role", env: map[string]string{"AWS_ACCESS_KEY_ID": "ak", "AWS_SECRET_ACCESS_KEY": "sk"}, err: "no region configured"},
+		{name: "partial env credentials", env: map[string]string{"AWS_ACCESS_KEY_ID": "ak"}, err: "AWS_ACCESS_KEY_ID is set without AWS_SECRET_ACCESS_KEY"},
+		{name: "invalid endpoint", env: map[string]string{"AWS_ENDPOINT_URL": "ftp://host"}, err: `AWS_ENDPOINT_URL: endpoint_url "ftp://host" must use http or https`},
+		{name: "endpoint with path", env: map[string]string{"AWS_ENDPOINT_URL_S3": "http://host/path"}, err: "must not have a path"},
+		{name: "role loop", profile: "loop-a", err: `profile "loop-b": source_profile "loop-a" loops back in the role chain`},
+		{name: "ambiguous role source", profile: "ambiguous", err: "mutually exclusive"},
+		{name: "role without source", profile: "orphan", err: "role_arn requires source_profile, credential_source or web_identity_token_file"},
+		{name: "web identity without role", env: map[string]string{"AWS_WEB_IDENTITY_TOKEN_FILE": "/token"}, err: "AWS_WEB_IDENTITY_TOKEN_FILE is set without AWS_ROLE_ARN"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := LoadOptions(newTestConfigSources(t, tc.profile, tc.env))
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// configSection is a section of the shared config or credentials file.
+// The subsections are the properties with an empty value followed by indented properties,
+// e.g. the s3 settings of a profile.
+type configSection struct {
+	properties  map[string]string
+	subsections map[string]map[string]string
+}
+
+// configFile is a parsed shared config or credentials file, indexed by section name.
+type configFile map[string]*configSection
+
+// loadConfigFile parses the file at path, a missing file is empty.
+func loadConfigFile(path string) (configFile, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return configFile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ret, err := parseConfigFile(bufio.NewScanner(f))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return ret, nil
+}
+
+func parseConfigFile(scanner *bufio.Scanner) (configFile, error) {
+	ret := configFile{}
+
+	var section *configSection
+	var subsection map[string]string
+	var lastKey string
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		rawLine := scanner.Text()
+		line := strings.TrimSpace(rawLine)
+
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		indented := rawLine[0] == ' ' || rawLine[0] == '\t'
+
+		switch {
+		case line[0] == '[':
+			if line[len(line)-1] != ']' {
+				return nil, fmt.Errorf("line %d: unterminated section header %q", lineNumber, line)
+			}
+
+			name := strings.Join(strings.Fields(line[1:len(line)-1]), " ")
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty section name", lineNumber)
+			}
+
+			section = ret[name]
+			if section == nil {
+				section = &configSection{
+					properties:  map[string]string{},
+					subsections: map[string]map[string]string{},
+				}
+				ret[name] = section
+			}
+
+			subsection = nil
+			lastKey = ""
+
+		case section == nil:
+			return nil, fmt.Errorf("line %d: property outside of a section", lineNumber)
+
+		case indented && subsection != nil:
+			key, value, err := parseConfigProperty(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			subsection[key] = value
+
+		case indented && lastKey != "":
+			// Continuation of a multi-line value
+			section.properties[lastKey] += " " + line
+
+		default:
+			key, value, err := parseConfigProperty(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			subsection = nil
+			lastKey = key
+
+			if value == "" {
+				subsection = section.subsections[key]
+				if subsection == nil {
+					subsection = map[string]string{}
+					section.subsections[key] = subsection
+				}
+				continue
+			}
+
+			section.properties[key] = value
+		}
+	}
+
+	return ret, scanner.Err()
+}
+
+func parseConfigProperty(line string) (key, value string, err error) {
+	key, value, found := strings.Cut(line, "=")
+	if !found {
+		return "", "", fmt.Errorf("expected key = value, got %q", line)
+	}
+
+	key = strings.ToLower(strings.TrimSpace(key))
+	if key == "" {
+		return "", "", fmt.Errorf("empty key in %q", line)
+	}
+
+	// Commands may legitimately contain comment markers
+	if key == "credential_process" {
+		return key, strings.TrimSpace(value), nil
+	}
+
+	// Inline comments must be preceded by a whitespace
+	for _, marker := range []string{" #", "\t#", " ;", "\t;"} {
+		if i := strings.Index(value, marker); i >= 0 {
+			value = value[:i]
+		}
+	}
+
+	return key, strings.TrimSpace(value), nil
+}
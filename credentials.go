@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/s3hobby/client/pkg/signer"
+)
+
+// credentialsExpiryWindow is the margin to refresh the credentials before their expiration,
+// so that the signed requests reach the server with valid credentials.
+const credentialsExpiryWindow = 5 * time.Minute
+
+// CredentialsProvider retrieves the credentials signing the requests.
+type CredentialsProvider interface {
+	Retrieve(ctx context.Context) (*signer.Credentials, error)
+}
+
+var _ CredentialsProvider = (*StaticCredentialsProvider)(nil)
+
+// StaticCredentialsProvider provides credentials that never change.
+type StaticCredentialsProvider struct {
+	Credentials *signer.Credentials
+}
+
+func (p *StaticCredentialsProvider) Retrieve(_ context.Context) (*signer.Credentials, error) {
+	return p.Credentials, nil
+}
+
+var _ CredentialsProvider = (*CredentialsCache)(nil)
+
+// CredentialsCache retrieves the credentials from a provider once, then again
+// when they are about to expire.
+type CredentialsCache struct {
+	provider CredentialsProvider
+
+	mu          sync.Mutex
+	credentials *signer.Credentials
+}
+
+func NewCredentialsCache(provider CredentialsProvider) *CredentialsCache {
+	return &CredentialsCache{
+		provider: provider,
+	}
+}
+
+func (c *CredentialsCache) Retrieve(ctx context.Context) (*signer.Credentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.credentials != nil && !expiresSoon(c.credentials, time.Now()) {
+		return c.credentials, nil
+	}
+
+	credentials, err := c.provider.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.credentials = credentials
+	return credentials, nil
+}
+
+// Invalidate forces the next Retrieve to get new credentials from the provider.
+func (c *CredentialsCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.credentials = nil
+}
+
+func expiresSoon(credentials *signer.Credentials, now time.Time) bool {
+	return !credentials.Expires.IsZero() && !now.Add(credentialsExpiryWindow).Before(credentials.Expires)
+}
@@ -0,0 +1,228 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/s3hobby/client/pkg/signer"
+
+	"github.com/valyala/fasthttp"
+)
+
+// DefaultEC2MetadataEndpoint is the endpoint of the EC2 instance metadata service.
+const DefaultEC2MetadataEndpoint = "http://169.254.169.254"
+
+// DefaultContainerCredentialsEndpoint is the endpoint serving AWS_CONTAINER_CREDENTIALS_RELATIVE_URI on ECS.
+const DefaultContainerCredentialsEndpoint = "http://169.254.170.2"
+
+const imdsHeaderToken = "X-aws-ec2-metadata-token"
+const imdsHeaderTokenTTLSeconds = "X-aws-ec2-metadata-token-ttl-seconds"
+const imdsPathSecurityCredentials = "/latest/meta-data/iam/security-credentials/"
+const imdsPathToken = "/latest/api/token"
+
+// imdsTokenTTL is the lifetime requested for the IMDSv2 session tokens, the maximum allowed.
+const imdsTokenTTL = 6 * time.Hour
+
+// metadataCredentials is the JSON document of the instance metadata and container credentials endpoints.
+type metadataCredentials struct {
+	Code            string    `json:"Code"`
+	Message         string    `json:"Message"`
+	AccessKeyID     string    `json:"AccessKeyId"`
+	SecretAccessKey string    `json:"SecretAccessKey"`
+	Token           string    `json:"Token"`
+	Expiration      time.Time `json:"Expiration"`
+}
+
+func parseMetadataCredentials(source string, body []byte) (*signer.Credentials, error) {
+	var output metadataCredentials
+	if err := json.Unmarshal(body, &output); err != nil {
+		return nil, fmt.Errorf("%s: invalid credentials: %w", source, err)
+	}
+
+	// The instance metadata service reports failures with a code other than Success
+	if output.Code != "" && output.Code != "Success" {
+		return nil, fmt.Errorf("%s: %s: %s", source, output.Code, output.Message)
+	}
+
+	if output.AccessKeyID == "" || output.SecretAccessKey == "" {
+		return nil, fmt.Errorf("%s: AccessKeyId and SecretAccessKey are mandatory", source)
+	}
+
+	return &signer.Credentials{
+		AccessKeyID:     output.AccessKeyID,
+		SecretAccessKey: output.SecretAccessKey,
+		SessionToken:    output.Token,
+		Expires:         output.Expiration,
+	}, nil
+}
+
+var _ CredentialsProvider = (*EC2RoleProvider)(nil)
+
+// EC2RoleProvider retrieves the credentials of the IAM role of the EC2 instance
+// from the instance metadata service, using the IMDSv2 session tokens.
+// Wrap it in a [CredentialsCache] to refresh the credentials before their expiration.
+type EC2RoleProvider struct {
+	// Endpoint defaults to [DefaultEC2MetadataEndpoint].
+	Endpoint string
+
+	// HTTPClient default to [DefaultHTTPClient].
+	HTTPClient HTTPClient
+
+	mu           sync.Mutex
+	token        string
+	tokenExpires time.Time
+}
+
+func (p *EC2RoleProvider) Retrieve(_ context.Context) (*signer.Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	roles, err := p.get(imdsPathSecurityCredentials)
+	if err != nil {
+		return nil, err
+	}
+
+	role, _, _ := strings.Cut(strings.TrimSpace(string(roles)), "\n")
+	if role == "" {
+		return nil, errors.New("instance metadata: no IAM role attached to the instance")
+	}
+
+	body, err := p.get(imdsPathSecurityCredentials + role)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseMetadataCredentials("instance metadata", body)
+}
+
+func (p *EC2RoleProvider) endpoint() string {
+	if p.Endpoint == "" {
+		return DefaultEC2MetadataEndpoint
+	}
+
+	return strings.TrimSuffix(p.Endpoint, "/")
+}
+
+func (p *EC2RoleProvider) httpClient() HTTPClient {
+	if p.HTTPClient == nil {
+		return DefaultHTTPClient
+	}
+
+	return p.HTTPClient
+}
+
+// get reads the metadata at path, with a new session token when the current one is rejected.
+func (p *EC2RoleProvider) get(path string) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		if err := p.refreshToken(); err != nil {
+			return nil, err
+		}
+
+		var req fasthttp.Request
+		var resp fasthttp.Response
+
+		req.SetRequestURI(p.endpoint() + path)
+		req.Header.Set(imdsHeaderToken, p.token)
+
+		if err := p.httpClient().Do(&req, &resp); err != nil {
+			return nil, fmt.Errorf("instance metadata: HTTP request error: %v", err)
+		}
+
+		switch statusCode := resp.StatusCode(); {
+		case statusCode == fasthttp.StatusOK:
+			return resp.Body(), nil
+		case statusCode == fasthttp.StatusUnauthorized && attempt == 0:
+			p.token = ""
+		default:
+			return nil, fmt.Errorf("instance metadata: GET %s: HTTP %d", path, statusCode)
+		}
+	}
+}
+
+// refreshToken gets a new session token when the current one is missing or expired.
+func (p *EC2RoleProvider) refreshToken() error {
+	now := time.Now()
+	if p.token != "" && now.Before(p.tokenExpires) {
+		return nil
+	}
+
+	var req fasthttp.Request
+	var resp fasthttp.Response
+
+	req.Header.SetMethod(fasthttp.MethodPut)
+	req.SetRequestURI(p.endpoint() + imdsPathToken)
+	req.Header.Set(imdsHeaderTokenTTLSeconds, strconv.Itoa(int(imdsTokenTTL.Seconds())))
+
+	if err := p.httpClient().Do(&req, &resp); err != nil {
+		return fmt.Errorf("instance metadata: HTTP request error: %v", err)
+	}
+
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return fmt.Errorf("instance metadata: cannot get a session token: HTTP %d", resp.StatusCode())
+	}
+
+	p.token = string(resp.Body())
+	// Renewed before its expiration, as the credentials
+	p.tokenExpires = now.Add(imdsTokenTTL - credentialsExpiryWindow)
+
+	return nil
+}
+
+var _ CredentialsProvider = (*ContainerCredentialsProvider)(nil)
+
+// ContainerCredentialsProvider retrieves the credentials of the container from the endpoint
+// of AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or AWS_CONTAINER_CREDENTIALS_FULL_URI, such as on ECS or EKS.
+// Wrap it in a [CredentialsCache] to refresh the credentials before their expiration.
+type ContainerCredentialsProvider struct {
+	URI string
+
+	// AuthorizationTokenFile is read on each retrieval, since the token is rotated.
+	// It takes precedence over AuthorizationToken. Both are sent as the Authorization header.
+	AuthorizationTokenFile string
+	AuthorizationToken     string
+
+	// HTTPClient default to [DefaultHTTPClient].
+	HTTPClient HTTPClient
+}
+
+func (p *ContainerCredentialsProvider) Retrieve(_ context.Context) (*signer.Credentials, error) {
+	authorization := p.AuthorizationToken
+	if p.AuthorizationTokenFile != "" {
+		token, err := os.ReadFile(p.AuthorizationTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("container credentials: cannot read the authorization token: %w", err)
+		}
+
+		authorization = strings.TrimSpace(string(token))
+	}
+
+	httpClient := p.HTTPClient
+	if httpClient == nil {
+		httpClient = DefaultHTTPClient
+	}
+
+	var req fasthttp.Request
+	var resp fasthttp.Response
+
+	req.SetRequestURI(p.URI)
+	if authorization != "" {
+		req.Header.Set(fasthttp.HeaderAuthorization, authorization)
+	}
+
+	if err := httpClient.Do(&req, &resp); err != nil {
+		return nil, fmt.Errorf("container credentials: HTTP request error: %v", err)
+	}
+
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return nil, fmt.Errorf("container credentials: GET %s: HTTP %d: %s", p.URI, resp.StatusCode(), strings.TrimSpace(string(resp.Body())))
+	}
+
+	return parseMetadataCredentials("container credentials", resp.Body())
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/s3hobby/client/pkg/fasthttptesting"
+	"github.com/s3hobby/client/pkg/signer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+const testMetadataCredentials = `{
+  "Code": "Success",
+  "LastUpdated": "2030-01-01T00:00:00Z",
+  "Type": "AWS-HMAC",
+  "AccessKeyId": "ASIAMETADATA",
+  "SecretAccessKey": "metadata-secret",
+  "Token": "metadata-token",
+  "Expiration": "2030-01-02T03:04:05Z"
+}`
+
+var testMetadataSignerCredentials = &signer.Credentials{
+	AccessKeyID:     "ASIAMETADATA",
+	SecretAccessKey: "metadata-secret",
+	SessionToken:    "metadata-token",
+	Expires:         time.Date(2030, time.January, 2, 3, 4, 5, 0, time.UTC),
+}
+
+// fakeIMDS is an instance metadata service issuing numbered session tokens.
+type fakeIMDS struct {
+	t      *testing.T
+	tokens int
+	role   string
+}
+
+func (s *fakeIMDS) handle(ctx *fasthttp.RequestCtx) {
+	validToken := "token-" + strconv.Itoa(s.tokens)
+
+	switch path := string(ctx.Path()); {
+	case path == "/latest/api/token":
+		assert.Equal(s.t, fasthttp.MethodPut, string(ctx.Method()))
+		assert.Equal(s.t, "21600", string(ctx.Request.Header.Peek("X-aws-ec2-metadata-token-ttl-seconds")))
+
+		s.tokens++
+		ctx.SetBodyString("token-" + strconv.Itoa(s.tokens))
+
+	case string(ctx.Request.Header.Peek("X-aws-ec2-metadata-token")) != validToken:
+		ctx.SetStatusCode(fasthttp.StatusUnauthorized)
+
+	case path == "/latest/meta-data/iam/security-credentials/":
+		ctx.SetBodyString(s.role)
+
+	case path == "/latest/meta-data/iam/security-credentials/"+s.role:
+		ctx.SetBodyString(testMetadataCredentials)
+
+	default:
+		ctx.SetStatusCode(fasthttp.StatusNotFound)
+	}
+}
+
+func TestEC2RoleProvider(t *testing.T) {
+	imds := &fakeIMDS{t: t, role: "my-role"}
+	srv := fasthttptesting.NewInmemoryTester(imds.handle)
+	defer srv.Close()
+
+	provider := &EC2RoleProvider{HTTPClient: srv.Client()}
+
+	credentials, err := provider.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, testMetadataSignerCredentials, credentials)
+
+	_, err = provider.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, imds.tokens, "the session token is reused")
+
+	// The service rotated its token
+	imds.tokens++
+	_, err = provider.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 3, imds.tokens)
+
+	imds.role = ""
+	_, err = provider.Retrieve(t.Context())
+	require.ErrorContains(t, err, "no IAM role attached to the instance")
+}
+
+func TestContainerCredentialsProvider(t *testing.T) {
+	srv := fasthttptesting.NewInmemoryTester(func(ctx *fasthttp.RequestCtx) {
+		assert.Equal(t, "169.254.170.2", string(ctx.Host()))
+		assert.Equal(t, "/v2/credentials/id", string(ctx.Path()))
+
+		if string(ctx.Request.Header.Peek("Authorization")) != "secret" {
+			ctx.SetStatusCode(fasthttp.StatusForbidden)
+			ctx.SetBodyString(`{"code":"AccessDenied","message":"invalid token"}`)
+			return
+		}
+
+		ctx.SetBodyString(testMetadataCredentials)
+	})
+	defer srv.Close()
+
+	provider := &ContainerCredentialsProvider{
+		URI:                "http://169.254.170.2/v2/credentials/id",
+		AuthorizationToken: "secret",
+		HTTPClient:         srv.Client(),
+	}
+
+	credentials, err := provider.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, testMetadataSignerCredentials, credentials)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("rotated\n"), 0o600))
+	provider.AuthorizationTokenFile = tokenFile
+
+	_, err = provider.Retrieve(t.Context())
+	require.ErrorContains(t, err, "HTTP 403")
+	require.ErrorContains(t, err, "invalid token")
+}
+
+func TestLoadOptions_metadataCredentials(t *testing.T) {
+	imds := &fakeIMDS{t: t, role: "my-role"}
+	srv := fasthttptesting.NewInmemoryTester(imds.handle)
+	defer srv.Close()
+
+	opts, err := LoadOptions(newTestConfigSources(t, "shared", nil), func(o *Options) {
+		o.HTTPClient = srv.Client()
+	})
+	require.NoError(t, err)
+
+	credentials, err := opts.CredentialsProvider.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, testMetadataSignerCredentials, credentials)
+
+	opts, err = LoadOptions(newTestConfigSources(t, "shared", map[string]string{
+		"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI": "/v2/credentials/id",
+	}))
+	require.NoError(t, err)
+
+	provider, ok := opts.CredentialsProvider.(*CredentialsCache)
+	require.True(t, ok)
+	require.Equal(t, &ContainerCredentialsProvider{URI: "http://169.254.170.2/v2/credentials/id"}, provider.provider)
+
+	opts, err = LoadOptions(newTestConfigSources(t, "shared", map[string]string{"AWS_EC2_METADATA_DISABLED": "true"}))
+	require.NoError(t, err)
+	require.Nil(t, opts.CredentialsProvider)
+
+	for fullURI, valid := range map[string]bool{
+		"https://credentials.example.com/creds": true,
+		"http://127.0.0.1:8080/creds":           true,
+		"http://localhost/creds":                true,
+		"http://[fd00:ec2::23]/creds":           true,
+		"http://169.254.170.23/v1/credentials":  true,
+		"http://credentials.example.com/creds":  false,
+		"http://10.0.0.1/creds":                 false,
+	} {
+		_, err := LoadOptions(newTestConfigSources(t, "shared", map[string]string{
+			"AWS_CONTAINER_CREDENTIALS_FULL_URI": fullURI,
+		}))
+
+		if valid {
+			require.NoError(t, err, fullURI)
+		} else {
+			require.ErrorContains(t, err, "must use https", fullURI)
+		}
+	}
+}
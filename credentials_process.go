@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/s3hobby/client/pkg/signer"
+)
+
+// processCredentialsVersion is the only supported version of the credential_process output.
+const processCredentialsVersion = 1
+
+var _ CredentialsProvider = (*ProcessCredentialsProvider)(nil)
+
+// ProcessCredentialsProvider runs an external command printing the credentials on
+// its standard output, as the credential_process setting of the shared config file.
+//
+// The output is a JSON document such as:
+//
+//	{
+//	  "Version": 1,
+//	  "AccessKeyId": "...",
+//	  "SecretAccessKey": "...",
+//	  "SessionToken": "...",
+//	  "Expiration": "2006-01-02T15:04:05Z"
+//	}
+type ProcessCredentialsProvider struct {
+	command string
+}
+
+// NewProcessCredentialsProvider returns a provider running command with the system shell.
+func NewProcessCredentialsProvider(command string) *ProcessCredentialsProvider {
+	return &ProcessCredentialsProvider{
+		command: command,
+	}
+}
+
+type processCredentialsOutput struct {
+	Version         int        `json:"Version"`
+	AccessKeyID     string     `json:"AccessKeyId"`
+	SecretAccessKey string     `json:"SecretAccessKey"`
+	SessionToken    string     `json:"SessionToken"`
+	Expiration      *time.Time `json:"Expiration"`
+}
+
+func (p *ProcessCredentialsProvider) Retrieve(ctx context.Context) (*signer.Credentials, error) {
+	if strings.TrimSpace(p.command) == "" {
+		return nil, errors.New("credential_process: empty command")
+	}
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd.exe", "/C"
+	}
+
+	//nolint:gosec // running the configured command is the purpose of credential_process
+	stdout, err := exec.CommandContext(ctx, shell, flag, p.command).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("credential_process %q: %w: %s", p.command, err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+
+		return nil, fmt.Errorf("credential_process %q: %w", p.command, err)
+	}
+
+	var output processCredentialsOutput
+	if err = json.Unmarshal(stdout, &output); err != nil {
+		return nil, fmt.Errorf("credential_process %q: invalid output: %w", p.command, err)
+	}
+
+	if output.Version != processCredentialsVersion {
+		return nil, fmt.Errorf("credential_process %q: unsupported version %d, expected %d", p.command, output.Version, processCredentialsVersion)
+	}
+
+	if output.AccessKeyID == "" || output.SecretAccessKey == "" {
+		return nil, fmt.Errorf("credential_process %q: AccessKeyId and SecretAccessKey are mandatory", p.command)
+	}
+
+	credentials := &signer.Credentials{
+		AccessKeyID:     output.AccessKeyID,
+		SecretAccessKey: output.SecretAccessKey,
+		SessionToken:    output.SessionToken,
+	}
+
+	if output.Expiration != nil {
+		credentials.Expires = *output.Expiration
+	}
+
+	return credentials, nil
+}
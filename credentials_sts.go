@@ -0,0 +1,238 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/s3hobby/client/pkg/signer"
+	v4 "github.com/s3hobby/client/pkg/signer/v4"
+
+	"github.com/valyala/fasthttp"
+)
+
+const stsAPIVersion = "2011-06-15"
+const stsService = "sts"
+
+const stsActionAssumeRole = "AssumeRole"
+const stsActionAssumeRoleWithWebIdentity = "AssumeRoleWithWebIdentity"
+
+const stsParamAction = "Action"
+const stsParamDurationSeconds = "DurationSeconds"
+const stsParamExternalID = "ExternalId"
+const stsParamRoleArn = "RoleArn"
+const stsParamRoleSessionName = "RoleSessionName"
+const stsParamVersion = "Version"
+const stsParamWebIdentityToken = "WebIdentityToken"
+
+// STSEndpoint locates the AWS Security Token Service assuming the roles.
+type STSEndpoint struct {
+	// Host defaults to the regional endpoint, sts.<Region>.amazonaws.com, using HTTPS.
+	Host   string
+	UseSSL bool
+
+	Region string
+
+	// HTTPClient default to [DefaultHTTPClient].
+	HTTPClient HTTPClient
+}
+
+type stsCredentials struct {
+	AccessKeyID     string    `xml:"AccessKeyId"`
+	SecretAccessKey string    `xml:"SecretAccessKey"`
+	SessionToken    string    `xml:"SessionToken"`
+	Expiration      time.Time `xml:"Expiration"`
+}
+
+type stsResponse struct {
+	AssumeRole                *stsCredentials `xml:"AssumeRoleResult>Credentials"`
+	AssumeRoleWithWebIdentity *stsCredentials `xml:"AssumeRoleWithWebIdentityResult>Credentials"`
+}
+
+type stsErrorResponse struct {
+	Code      string `xml:"Error>Code"`
+	Message   string `xml:"Error>Message"`
+	RequestID string `xml:"RequestId"`
+}
+
+// newSTSError returns the [ServerSideError] of a STS response, whose error is wrapped in an ErrorResponse.
+func newSTSError(resp *fasthttp.Response) *ServerSideError {
+	ret := NewServerSideError(resp)
+
+	var errorResponse stsErrorResponse
+	if err := xml.Unmarshal(resp.Body(), &errorResponse); err == nil && errorResponse.Code != "" {
+		ret.Code = errorResponse.Code
+		ret.Message = errorResponse.Message
+		ret.RequestID = errorResponse.RequestID
+	}
+
+	return ret
+}
+
+// call sends the action, signed with credentials when not nil, and returns the temporary credentials.
+func (e *STSEndpoint) call(action string, form *fasthttp.Args, credentials *signer.Credentials) (*signer.Credentials, error) {
+	if e.Region == "" {
+		return nil, fmt.Errorf("STS %s: region is mandatory", action)
+	}
+
+	host, useSSL := e.Host, e.UseSSL
+	if host == "" {
+		host, useSSL = "sts."+e.Region+".amazonaws.com", true
+	}
+
+	scheme := "http"
+	if useSSL {
+		scheme = "https"
+	}
+
+	httpClient := e.HTTPClient
+	if httpClient == nil {
+		httpClient = DefaultHTTPClient
+	}
+
+	form.Set(stsParamAction, action)
+	form.Set(stsParamVersion, stsAPIVersion)
+
+	var req fasthttp.Request
+	var resp fasthttp.Response
+
+	req.Header.SetMethod(fasthttp.MethodPost)
+	req.SetRequestURI(scheme + "://" + host + "/")
+	req.Header.SetContentType("application/x-www-form-urlencoded")
+	req.SetBody(form.QueryString())
+
+	if credentials != nil {
+		s := v4.NewHeaderSigner(true, false)
+		s.SetService(stsService)
+
+		if _, _, err := s.Sign(&req, credentials, e.Region, time.Now()); err != nil {
+			return nil, fmt.Errorf("STS %s: cannot sign the request: %v", action, err)
+		}
+	}
+
+	if err := httpClient.Do(&req, &resp); err != nil {
+		return nil, fmt.Errorf("STS %s: HTTP request error: %v", action, err)
+	}
+
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return nil, fmt.Errorf("STS %s: %w", action, newSTSError(&resp))
+	}
+
+	var output stsResponse
+	if err := xml.Unmarshal(resp.Body(), &output); err != nil {
+		return nil, fmt.Errorf("STS %s: invalid response: %v", action, err)
+	}
+
+	result := output.AssumeRole
+	if action == stsActionAssumeRoleWithWebIdentity {
+		result = output.AssumeRoleWithWebIdentity
+	}
+
+	if result == nil || result.AccessKeyID == "" || result.SecretAccessKey == "" {
+		return nil, fmt.Errorf("STS %s: no credentials in the response", action)
+	}
+
+	return &signer.Credentials{
+		AccessKeyID:     result.AccessKeyID,
+		SecretAccessKey: result.SecretAccessKey,
+		SessionToken:    result.SessionToken,
+		Expires:         result.Expiration,
+	}, nil
+}
+
+// stsRoleForm returns the parameters shared by the AssumeRole actions.
+func stsRoleForm(roleARN, roleSessionName string, durationSeconds int) *fasthttp.Args {
+	if roleSessionName == "" {
+		roleSessionName = "s3hobby-client-" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	form := &fasthttp.Args{}
+	form.Set(stsParamRoleArn, roleARN)
+	form.Set(stsParamRoleSessionName, roleSessionName)
+
+	if durationSeconds > 0 {
+		form.Set(stsParamDurationSeconds, strconv.Itoa(durationSeconds))
+	}
+
+	return form
+}
+
+var _ CredentialsProvider = (*AssumeRoleProvider)(nil)
+
+// AssumeRoleProvider retrieves the temporary credentials of a role, assumed with the credentials of Source.
+type AssumeRoleProvider struct {
+	STS    STSEndpoint
+	Source CredentialsProvider
+
+	RoleARN string
+
+	// RoleSessionName defaults to a generated name.
+	RoleSessionName string
+	ExternalID      string
+
+	// DurationSeconds defaults to the STS default, one hour.
+	DurationSeconds int
+}
+
+func (p *AssumeRoleProvider) Retrieve(ctx context.Context) (*signer.Credentials, error) {
+	if p.Source == nil {
+		return nil, fmt.Errorf("STS %s: source credentials are mandatory", stsActionAssumeRole)
+	}
+
+	source, err := p.Source.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("STS %s: cannot retrieve source credentials: %w", stsActionAssumeRole, err)
+	}
+
+	if source == nil {
+		return nil, fmt.Errorf("STS %s: source credentials are mandatory", stsActionAssumeRole)
+	}
+
+	form := stsRoleForm(p.RoleARN, p.RoleSessionName, p.DurationSeconds)
+	if p.ExternalID != "" {
+		form.Set(stsParamExternalID, p.ExternalID)
+	}
+
+	return p.STS.call(stsActionAssumeRole, form, source)
+}
+
+var _ CredentialsProvider = (*WebIdentityRoleProvider)(nil)
+
+// WebIdentityRoleProvider retrieves the temporary credentials of a role, assumed with
+// an OIDC token such as the projected service account token of Kubernetes.
+type WebIdentityRoleProvider struct {
+	STS STSEndpoint
+
+	RoleARN string
+
+	// RoleSessionName defaults to a generated name.
+	RoleSessionName string
+
+	// TokenFile is read on each retrieval, since the token is rotated.
+	TokenFile string
+
+	// DurationSeconds defaults to the STS default, one hour.
+	DurationSeconds int
+}
+
+func (p *WebIdentityRoleProvider) Retrieve(_ context.Context) (*signer.Credentials, error) {
+	token, err := os.ReadFile(p.TokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("STS %s: cannot read the web identity token: %w", stsActionAssumeRoleWithWebIdentity, err)
+	}
+
+	webIdentityToken := strings.TrimSpace(string(token))
+	if webIdentityToken == "" {
+		return nil, fmt.Errorf("STS %s: empty web identity token file %s", stsActionAssumeRoleWithWebIdentity, p.TokenFile)
+	}
+
+	form := stsRoleForm(p.RoleARN, p.RoleSessionName, p.DurationSeconds)
+	form.Set(stsParamWebIdentityToken, webIdentityToken)
+
+	// The token authenticates the request, which is not signed
+	return p.STS.call(stsActionAssumeRoleWithWebIdentity, form, nil)
+}
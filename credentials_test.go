@@ -0,0 +1,256 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/s3hobby/client/pkg/fasthttptesting"
+	"github.com/s3hobby/client/pkg/signer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+type countingCredentialsProvider struct {
+	credentials signer.Credentials
+	calls       int
+}
+
+func (p *countingCredentialsProvider) Retrieve(context.Context) (*signer.Credentials, error) {
+	p.calls++
+	credentials := p.credentials
+	return &credentials, nil
+}
+
+func TestCredentialsCache(t *testing.T) {
+	provider := &countingCredentialsProvider{
+		credentials: signer.Credentials{AccessKeyID: "AK", SecretAccessKey: "SK", Expires: time.Now().Add(time.Hour)},
+	}
+	cache := NewCredentialsCache(provider)
+
+	credentials, err := cache.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "AK", credentials.AccessKeyID)
+
+	_, err = cache.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, provider.calls)
+
+	cache.Invalidate()
+	_, err = cache.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 2, provider.calls)
+
+	// Refreshed within the expiry window
+	provider.credentials.Expires = time.Now().Add(time.Minute)
+	cache.Invalidate()
+	_, err = cache.Retrieve(t.Context())
+	require.NoError(t, err)
+	_, err = cache.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 4, provider.calls)
+}
+
+func TestProcessCredentialsProvider(t *testing.T) {
+	credentials, err := NewProcessCredentialsProvider(
+		`echo '{"Version": 1, "AccessKeyId": "AK", "SecretAccessKey": "SK", "SessionToken": "token", "Expiration": "2030-01-02T03:04:05Z"}'`,
+	).Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, &signer.Credentials{
+		AccessKeyID:     "AK",
+		SecretAccessKey: "SK",
+		SessionToken:    "token",
+		Expires:         time.Date(2030, time.January, 2, 3, 4, 5, 0, time.UTC),
+	}, credentials)
+
+	credentials, err = NewProcessCredentialsProvider(`echo '{"Version": 1, "AccessKeyId": "AK", "SecretAccessKey": "SK"}'`).Retrieve(t.Context())
+	require.NoError(t, err)
+	require.True(t, credentials.Expires.IsZero())
+
+	for command, expectedErr := range map[string]string{
+		"":                             "empty command",
+		"echo oops >&2; exit 3":        "exit status 3: oops",
+		"echo not json":                "invalid output",
+		`echo '{"Version": 2}'`:        "unsupported version 2",
+		`echo '{"Version": 1}'`:        "AccessKeyId and SecretAccessKey are mandatory",
+		`echo '{"AccessKeyId": "AK"}'`: "unsupported version 0",
+	} {
+		_, err := NewProcessCredentialsProvider(command).Retrieve(t.Context())
+		require.ErrorContains(t, err, expectedErr, command)
+	}
+}
+
+const testSTSCredentials = `<Credentials>
+      <AccessKeyId>ASIAROLE</AccessKeyId>
+      <SecretAccessKey>role-secret</SecretAccessKey>
+      <SessionToken>role-token</SessionToken>
+      <Expiration>2030-01-02T03:04:05Z</Expiration>
+    </Credentials>`
+
+// newTestSTSServer answers the STS actions, checking the form parameters.
+func newTestSTSServer(t *testing.T, expectedForm map[string]string) fasthttptesting.InmemoryTester {
+	t.Helper()
+
+	return fasthttptesting.NewInmemoryTester(func(ctx *fasthttp.RequestCtx) {
+		assert.Equal(t, fasthttp.MethodPost, string(ctx.Method()))
+		assert.Equal(t, "sts.local", string(ctx.Host()))
+
+		form := ctx.PostArgs()
+		if string(form.Peek("RoleArn")) == "arn:aws:iam::123456789012:role/denied" {
+			ctx.SetStatusCode(fasthttp.StatusForbidden)
+			ctx.SetBodyString(`<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error><Type>Sender</Type><Code>AccessDenied</Code><Message>Not authorized</Message></Error>
+  <RequestId>request-id</RequestId>
+</ErrorResponse>`)
+			return
+		}
+
+		for key, value := range expectedForm {
+			assert.Equal(t, value, string(form.Peek(key)), key)
+		}
+
+		action := string(form.Peek("Action"))
+		authorization := string(ctx.Request.Header.Peek("Authorization"))
+
+		switch action {
+		case "AssumeRole":
+			assert.Contains(t, authorization, "/eu-west-3/sts/aws4_request")
+		case "AssumeRoleWithWebIdentity":
+			assert.Empty(t, authorization)
+		}
+
+		ctx.SetBodyString(`<` + action + `Response xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <` + action + `Result>
+    ` + testSTSCredentials + `
+  </` + action + `Result>
+  <ResponseMetadata><RequestId>request-id</RequestId></ResponseMetadata>
+</` + action + `Response>`)
+	})
+}
+
+var testRoleCredentials = &signer.Credentials{
+	AccessKeyID:     "ASIAROLE",
+	SecretAccessKey: "role-secret",
+	SessionToken:    "role-token",
+	Expires:         time.Date(2030, time.January, 2, 3, 4, 5, 0, time.UTC),
+}
+
+func TestAssumeRoleProvider(t *testing.T) {
+	srv := newTestSTSServer(t, map[string]string{
+		"Version":         "2011-06-15",
+		"RoleArn":         "arn:aws:iam::123456789012:role/test",
+		"RoleSessionName": "session",
+		"ExternalId":      "external",
+		"DurationSeconds": "900",
+	})
+	defer srv.Close()
+
+	provider := &AssumeRoleProvider{
+		STS: STSEndpoint{
+			Host:       "sts.local",
+			Region:     "eu-west-3",
+			HTTPClient: srv.Client(),
+		},
+		Source: &StaticCredentialsProvider{
+			Credentials: &signer.Credentials{AccessKeyID: "AK", SecretAccessKey: "SK"},
+		},
+		RoleARN:         "arn:aws:iam::123456789012:role/test",
+		RoleSessionName: "session",
+		ExternalID:      "external",
+		DurationSeconds: 900,
+	}
+
+	credentials, err := provider.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, testRoleCredentials, credentials)
+
+	provider.RoleARN = "arn:aws:iam::123456789012:role/denied"
+	_, err = provider.Retrieve(t.Context())
+
+	var serverSideError *ServerSideError
+	require.ErrorAs(t, err, &serverSideError)
+	require.Equal(t, "AccessDenied", serverSideError.Code)
+	require.Equal(t, "Not authorized", serverSideError.Message)
+	require.Equal(t, "request-id", serverSideError.RequestID)
+
+	provider.Source = &StaticCredentialsProvider{}
+	_, err = provider.Retrieve(t.Context())
+	require.ErrorContains(t, err, "source credentials are mandatory")
+}
+
+func TestWebIdentityRoleProvider(t *testing.T) {
+	srv := newTestSTSServer(t, map[string]string{
+		"RoleArn":          "arn:aws:iam::123456789012:role/test",
+		"WebIdentityToken": "oidc-token",
+	})
+	defer srv.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("oidc-token\n"), 0o600))
+
+	provider := &WebIdentityRoleProvider{
+		STS: STSEndpoint{
+			Host:       "sts.local",
+			Region:     "eu-west-3",
+			HTTPClient: srv.Client(),
+		},
+		RoleARN:   "arn:aws:iam::123456789012:role/test",
+		TokenFile: tokenFile,
+	}
+
+	credentials, err := provider.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, testRoleCredentials, credentials)
+
+	provider.TokenFile = filepath.Join(t.TempDir(), "missing")
+	_, err = provider.Retrieve(t.Context())
+	require.ErrorContains(t, err, "cannot read the web identity token")
+}
+
+func TestLoadOptions_credentialsProviders(t *testing.T) {
+	srv := newTestSTSServer(t, map[string]string{
+		"RoleArn": "arn:aws:iam::123456789012:role/test",
+	})
+	defer srv.Close()
+
+	env := map[string]string{
+		"AWS_REGION":           "eu-west-3",
+		"AWS_ENDPOINT_URL_STS": "http://sts.local",
+	}
+
+	opts, err := LoadOptions(newTestConfigSources(t, "role", env), func(o *Options) {
+		o.HTTPClient = srv.Client()
+	})
+	require.NoError(t, err)
+	require.Nil(t, opts.Credentials)
+	require.Equal(t, "s3.eu-west-3.amazonaws.com", opts.EndpointHost)
+
+	credentials, err := opts.CredentialsProvider.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, testRoleCredentials, credentials)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("oidc-token"), 0o600))
+	env["AWS_WEB_IDENTITY_TOKEN_FILE"] = tokenFile
+	env["AWS_ROLE_ARN"] = "arn:aws:iam::123456789012:role/test"
+
+	opts, err = LoadOptions(newTestConfigSources(t, "", env), func(o *Options) {
+		o.HTTPClient = srv.Client()
+	})
+	require.NoError(t, err)
+
+	credentials, err = opts.CredentialsProvider.Retrieve(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, testRoleCredentials, credentials)
+
+	opts, err = LoadOptions(newTestConfigSources(t, "process", map[string]string{"AWS_REGION": "eu-west-3"}))
+	require.NoError(t, err)
+	require.IsType(t, &CredentialsCache{}, opts.CredentialsProvider)
+
+	_, err = opts.CredentialsProvider.Retrieve(t.Context())
+	require.ErrorContains(t, err, `credential_process "/bin/creds ; echo --profile dev"`)
+}
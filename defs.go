@@ -1,20 +1,63 @@
 package client
 
+const QueryACL = "acl"
+const QueryAnalytics = "analytics"
+const QueryAttributes = "attributes"
 const QueryBucketRegion = "bucket-region"
 const QueryContinuationToken = "continuation-token"
+const QueryCors = "cors"
+const QueryDelimiter = "delimiter"
+const QueryEncodingType = "encoding-type"
+const QueryEncryption = "encryption"
+const QueryID = "id"
+const QueryIntelligentTiering = "intelligent-tiering"
+const QueryInventory = "inventory"
+const QueryKeyMarker = "key-marker"
+const QueryLegalHold = "legal-hold"
+const QueryLifecycle = "lifecycle"
+const QueryLogging = "logging"
 const QueryMaxBuckets = "max-buckets"
+const QueryMaxKeys = "max-keys"
+const QueryMetrics = "metrics"
+const QueryNotification = "notification"
+const QueryObjectLock = "object-lock"
+const QueryOwnershipControls = "ownershipControls"
 const QueryPartNumber = "partNumber"
+const QueryPolicy = "policy"
+const QueryPolicyStatus = "policyStatus"
 const QueryPrefix = "prefix"
 const QueryLocation = "location"
+const QueryPublicAccessBlock = "publicAccessBlock"
+const QueryReplication = "replication"
+const QueryRequestPayment = "requestPayment"
 const QueryResponseCacheControl = "response-cache-control"
 const QueryResponseContentDisposition = "response-content-disposition"
 const QueryResponseContentEncoding = "response-content-encoding"
 const QueryResponseContentLanguage = "response-content-language"
 const QueryResponseContentType = "response-content-type"
 const QueryResponseExpires = "response-expires"
+const QueryRestore = "restore"
+const QueryRetention = "retention"
+const QuerySelect = "select"
+const QuerySelectType = "select-type"
+const QueryTagging = "tagging"
+const QueryUploadID = "uploadId"
 const QueryVersionID = "versionId"
+const QueryVersionIDMarker = "version-id-marker"
+const QueryVersioning = "versioning"
+const QueryVersions = "versions"
+const QueryWebsite = "website"
 
+const HeaderAcceptEncoding = "Accept-Encoding"
 const HeaderAcceptRanges = "Accept-Ranges"
+const HeaderAccessControlAllowCredentials = "Access-Control-Allow-Credentials"
+const HeaderAccessControlAllowHeaders = "Access-Control-Allow-Headers"
+const HeaderAccessControlAllowMethods = "Access-Control-Allow-Methods"
+const HeaderAccessControlAllowOrigin = "Access-Control-Allow-Origin"
+const HeaderAccessControlExposeHeaders = "Access-Control-Expose-Headers"
+const HeaderAccessControlMaxAge = "Access-Control-Max-Age"
+const HeaderAccessControlRequestHeaders = "Access-Control-Request-Headers"
+const HeaderAccessControlRequestMethod = "Access-Control-Request-Method"
 const HeaderCacheControl = "Cache-Control"
 const HeaderContentDisposition = "Content-Disposition"
 const HeaderContentEncoding = "Content-Encoding"
@@ -23,6 +66,7 @@ const HeaderContentLength = "Content-Length"
 const HeaderContentMD5 = "Content-MD5"
 const HeaderContentRange = "Content-Range"
 const HeaderContentType = "Content-Type"
+const HeaderDate = "Date"
 const HeaderETag = "ETag"
 const HeaderExpires = "Expires"
 const HeaderIfMatch = "If-Match"
@@ -31,13 +75,17 @@ const HeaderIfNoneMatch = "If-None-Match"
 const HeaderIfUnmodifiedSince = "If-Unmodified-Since"
 const HeaderLastModified = "Last-Modified"
 const HeaderLocation = "Location"
+const HeaderOrigin = "Origin"
 const HeaderRange = "Range"
+const HeaderVary = "Vary"
 const HeaderXAmzAccessPointAlias = "x-amz-access-point-alias"
 const HeaderXAmzACL = "x-amz-acl"
 const HeaderXAmzArchiveStatus = "x-amz-archive-status"
 const HeaderXAmzBucketKeyEnabled = "x-amz-server-side-encryption-bucket-key-enabled"
 const HeaderXAmzBucketObjectLockEnabled = "x-amz-bucket-object-lock-enabled"
+const HeaderXAmzBucketObjectLockToken = "x-amz-bucket-object-lock-token"
 const HeaderXAmzBucketRegion = "x-amz-bucket-region"
+const HeaderXAmzBypassGovernanceRetention = "x-amz-bypass-governance-retention"
 const HeaderXAmzChecksumAlgorithm = "x-amz-sdk-checksum-algorithm"
 const HeaderXAmzChecksumCRC32 = "x-amz-checksum-crc32"
 const HeaderXAmzChecksumCRC32C = "x-amz-checksum-crc32c"
@@ -46,6 +94,7 @@ const HeaderXAmzChecksumMode = "x-amz-checksum-mode"
 const HeaderXAmzChecksumSHA1 = "x-amz-checksum-sha1"
 const HeaderXAmzChecksumSHA256 = "x-amz-checksum-sha256"
 const HeaderXAmzChecksumType = "x-amz-checksum-type"
+const HeaderXAmzConfirmRemoveSelfBucketAccess = "x-amz-confirm-remove-self-bucket-access"
 const HeaderXAmzDeleteMarker = "x-amz-delete-marker"
 const HeaderXAmzExpectedBucketOwner = "x-amz-expected-bucket-owner"
 const HeaderXAmzExpiration = "x-amz-expiration"
@@ -54,19 +103,27 @@ const HeaderXAmzGrantRead = "x-amz-grant-read"
 const HeaderXAmzGrantReadACP = "x-amz-grant-read-acp"
 const HeaderXAmzGrantWrite = "x-amz-grant-write"
 const HeaderXAmzGrantWriteACP = "x-amz-grant-write-acp"
+const HeaderXAmzMaxParts = "x-amz-max-parts"
+const HeaderXAmzMFA = "x-amz-mfa"
 const HeaderXAmzMissingMeta = "x-amz-missing-meta"
+const HeaderXAmzMpObjectSize = "x-amz-mp-object-size"
+const HeaderXAmzObjectAttributes = "x-amz-object-attributes"
 const HeaderXAmzObjectLockLegalHoldStatus = "x-amz-object-lock-legal-hold"
 const HeaderXAmzObjectLockMode = "x-amz-object-lock-mode"
 const HeaderXAmzObjectLockRetainUntilDate = "x-amz-object-lock-retain-until-date"
 const HeaderXAmzObjectOwnership = "x-amz-object-ownership"
+const HeaderXAmzOptionalObjectAttributes = "x-amz-optional-object-attributes"
+const HeaderXAmzPartNumberMarker = "x-amz-part-number-marker"
 const HeaderXAmzPartsCount = "x-amz-mp-parts-count"
 const HeaderXAmzReplicationStatus = "x-amz-replication-status"
 const HeaderXAmzRequestCharged = "x-amz-request-charged"
 const HeaderXAmzRequestID = "X-Amz-Request-Id"
 const HeaderXAmzRequestPayer = "x-amz-request-payer"
 const HeaderXAmzRestore = "x-amz-restore"
+const HeaderXAmzRestoreOutputPath = "x-amz-restore-output-path"
 const HeaderXAmzServerSideEncryption = "x-amz-server-side-encryption"
 const HeaderXAmzSize = "x-amz-object-size"
+const HeaderXAmzSkipDestinationValidation = "x-amz-skip-destination-validation"
 const HeaderXAmzSSECustomerAlgorithm = "x-amz-server-side-encryption-customer-algorithm"
 const HeaderXAmzSSECustomerKey = "x-amz-server-side-encryption-customer-key"
 const HeaderXAmzSSECustomerKeyMD5 = "x-amz-server-side-encryption-customer-key-MD5"
@@ -76,6 +133,7 @@ const HeaderXAmzStorageClass = "x-amz-storage-class"
 const HeaderXAmzTagging = "x-amz-tagging"
 const HeaderXAmzTaggingCount = "x-amz-tagging-count"
 const HeaderXAmzTrailer = "x-amz-trailer"
+const HeaderXAmzTransitionDefaultMinimumObjectSize = "x-amz-transition-default-minimum-object-size"
 const HeaderXAmzVersionId = "x-amz-version-id"
 const HeaderXAmzWebsiteRedirectLocation = "x-amz-website-redirect-location"
 const HeaderXAmzWriteOffsetBytes = "x-amz-write-offset-bytes"
@@ -2,11 +2,24 @@ package client
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
 
 	"github.com/valyala/fasthttp"
 )
 
+// ErrPreconditionFailed matches, using [errors.Is], a [ServerSideError] caused by
+// a failed condition (If-Match, If-None-Match, ...). Retrying will fail again.
+var ErrPreconditionFailed = errors.New("precondition failed")
+
+// ErrConditionalRequestConflict matches, using [errors.Is], a [ServerSideError] caused by
+// a concurrent conditional write on the same object. The request can be retried.
+var ErrConditionalRequestConflict = errors.New("conditional request conflict")
+
+// ErrRequestTimeTooSkewed matches, using [errors.Is], a [ServerSideError] caused by
+// a local clock too far from the server one. The client corrects its clock and retries once.
+var ErrRequestTimeTooSkewed = errors.New("request time too skewed")
+
 type ClientSideError struct {
 	Err error
 }
@@ -55,6 +68,43 @@ func NewServerSideError(resp *fasthttp.Response) *ServerSideError {
 	return ret
 }
 
+// Is allows to match [ErrPreconditionFailed], [ErrConditionalRequestConflict] and [ErrRequestTimeTooSkewed].
+func (e *ServerSideError) Is(target error) bool {
+	switch target {
+	case ErrPreconditionFailed:
+		// HEAD responses have no body to read the error code from
+		return e.StatusCode == fasthttp.StatusPreconditionFailed
+	case ErrConditionalRequestConflict:
+		return e.StatusCode == fasthttp.StatusConflict && e.Code == "ConditionalRequestConflict"
+	case ErrRequestTimeTooSkewed:
+		return e.Code == "RequestTimeTooSkewed"
+	default:
+		return false
+	}
+}
+
+// Retryable reports whether sending the same request again may succeed.
+func (e *ServerSideError) Retryable() bool {
+	if errors.Is(e, ErrConditionalRequestConflict) {
+		return true
+	}
+
+	switch e.Code {
+	case "InternalError", "RequestTimeout", "SlowDown", "ServiceUnavailable":
+		return true
+	}
+
+	switch e.StatusCode {
+	case fasthttp.StatusInternalServerError,
+		fasthttp.StatusBadGateway,
+		fasthttp.StatusServiceUnavailable,
+		fasthttp.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
 func (e *ServerSideError) Error() string {
 	var code string
 	if e.Code != "" {
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -47,3 +48,55 @@ func TestServerSideError(t *testing.T) {
 		)
 	})
 }
+
+func TestServerSideError_Is(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		err                  *ServerSideError
+		preconditionFailed   bool
+		conditionalConflict  bool
+		requestTimeTooSkewed bool
+		retryable            bool
+	}{
+		{
+			name:               "precondition failed",
+			err:                &ServerSideError{Code: "PreconditionFailed", StatusCode: fasthttp.StatusPreconditionFailed},
+			preconditionFailed: true,
+		},
+		{
+			name:               "precondition failed without body",
+			err:                &ServerSideError{Code: "HTTP 412", StatusCode: fasthttp.StatusPreconditionFailed},
+			preconditionFailed: true,
+		},
+		{
+			name:                "conditional request conflict",
+			err:                 &ServerSideError{Code: "ConditionalRequestConflict", StatusCode: fasthttp.StatusConflict},
+			conditionalConflict: true,
+			retryable:           true,
+		},
+		{
+			name: "other conflict",
+			err:  &ServerSideError{Code: "BucketNotEmpty", StatusCode: fasthttp.StatusConflict},
+		},
+		{
+			name:                 "request time too skewed",
+			err:                  &ServerSideError{Code: "RequestTimeTooSkewed", StatusCode: fasthttp.StatusForbidden},
+			requestTimeTooSkewed: true,
+		},
+		{
+			name:      "slow down",
+			err:       &ServerSideError{Code: "SlowDown", StatusCode: fasthttp.StatusServiceUnavailable},
+			retryable: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error = tc.err
+			require.Equal(t, tc.preconditionFailed, errors.Is(err, ErrPreconditionFailed))
+			require.Equal(t, tc.conditionalConflict, errors.Is(err, ErrConditionalRequestConflict))
+			require.Equal(t, tc.requestTimeTooSkewed, errors.Is(err, ErrRequestTimeTooSkewed))
+			require.Equal(t, tc.retryable, tc.err.Retryable())
+		})
+	}
+}
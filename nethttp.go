@@ -0,0 +1,202 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/valyala/fasthttp"
+)
+
+var _ HTTPClient = (*NetHTTPClient)(nil)
+
+// NetHTTPClient is an [HTTPClient] sending the requests with a net/http client,
+// e.g. to reuse a transport with its proxy, mTLS and instrumentation settings.
+//
+// The header names are sent as set on the fasthttp request, not canonicalized,
+// and the declared trailers are sent after a chunked body stream.
+// The Accept-Encoding header defaults to identity, so that net/http does not
+// transparently decompress the response bodies as fasthttp would not.
+type NetHTTPClient struct {
+	client *http.Client
+
+	streamResponseBody bool
+}
+
+// NewNetHTTPClient returns an [HTTPClient] sending the requests with a copy of client,
+// [http.DefaultClient] when nil.
+// The redirections are not followed, the 3xx responses are returned as fasthttp does.
+func NewNetHTTPClient(client *http.Client) *NetHTTPClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	clientCopy := *client
+	clientCopy.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	return &NetHTTPClient{
+		client: &clientCopy,
+	}
+}
+
+// SetStreamResponseBody makes the responses read the body from the connection on demand,
+// as [fasthttp.Client.StreamResponseBody]. The caller must then close the body stream
+// with [fasthttp.Response.CloseBodyStream], and the response trailers are not available.
+func (c *NetHTTPClient) SetStreamResponseBody(stream bool) {
+	c.streamResponseBody = stream
+}
+
+func (c *NetHTTPClient) Do(req *fasthttp.Request, resp *fasthttp.Response) error {
+	httpReq, err := newNetHTTPRequest(req)
+	if err != nil {
+		return err
+	}
+
+	httpResp, err := c.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+
+	if err = readNetHTTPResponseHeader(httpResp, &resp.Header); err != nil {
+		httpResp.Body.Close()
+		return err
+	}
+
+	if c.streamResponseBody {
+		resp.SetBodyStream(httpResp.Body, int(httpResp.ContentLength))
+		return nil
+	}
+
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("cannot read the response body: %w", err)
+	}
+
+	// Keep the Content-Length of the responses without body, e.g. to HEAD requests
+	contentLength := resp.Header.ContentLength()
+	resp.SetBody(body)
+	if len(body) == 0 && contentLength > 0 {
+		resp.Header.SetContentLength(contentLength)
+	}
+
+	// The trailers are received with the end of the body
+	for key, values := range httpResp.Trailer {
+		for _, value := range values {
+			resp.Header.Add(key, value)
+		}
+	}
+
+	return nil
+}
+
+// newNetHTTPRequest converts req, streaming its body stream.
+func newNetHTTPRequest(req *fasthttp.Request) (*http.Request, error) {
+	var body io.Reader = http.NoBody
+	contentLength := int64(-1)
+
+	if req.IsBodyStream() {
+		body = req.BodyStream()
+		if size := req.Header.ContentLength(); size >= 0 {
+			contentLength = int64(size)
+		}
+	} else if b := req.Body(); len(b) > 0 {
+		body = bytes.NewReader(b)
+		contentLength = int64(len(b))
+	} else {
+		contentLength = 0
+	}
+
+	httpReq, err := http.NewRequestWithContext(context.Background(), string(req.Header.Method()), req.URI().String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert the request: %w", err)
+	}
+
+	httpReq.ContentLength = contentLength
+
+	if req.UseHostHeader && len(req.Header.Host()) > 0 {
+		httpReq.Host = string(req.Header.Host())
+	}
+
+	trailers := map[string]bool{}
+	req.Header.VisitAllTrailer(func(key []byte) {
+		trailers[strings.ToLower(string(key))] = true
+	})
+
+	req.Header.VisitAll(func(key, value []byte) {
+		name := string(key)
+
+		switch lowerName := strings.ToLower(name); {
+		case lowerName == "host", lowerName == "content-length", lowerName == "transfer-encoding", lowerName == "trailer":
+			// Managed by net/http
+		case trailers[lowerName]:
+			if httpReq.Trailer == nil {
+				httpReq.Trailer = http.Header{}
+			}
+			httpReq.Trailer[name] = append(httpReq.Trailer[name], string(value))
+		default:
+			// Not canonicalized, to send the header names as set
+			httpReq.Header[name] = append(httpReq.Header[name], string(value))
+		}
+	})
+
+	if len(req.Header.UserAgent()) == 0 {
+		// An empty value disables the default Go-http-client User-Agent, as fasthttp.Client.NoDefaultUserAgentHeader
+		httpReq.Header["User-Agent"] = []string{""}
+	}
+
+	if len(req.Header.Peek(HeaderAcceptEncoding)) == 0 {
+		httpReq.Header[HeaderAcceptEncoding] = []string{"identity"}
+	}
+
+	return httpReq, nil
+}
+
+// readNetHTTPResponseHeader converts the status and the headers of httpResp.
+// The header is parsed from its wire representation, since fasthttp ignores
+// the Date header set on a response.
+func readNetHTTPResponseHeader(httpResp *http.Response, header *fasthttp.ResponseHeader) error {
+	var raw bytes.Buffer
+
+	raw.WriteString("HTTP/1.1 ")
+	raw.WriteString(strconv.Itoa(httpResp.StatusCode))
+	raw.WriteString(" ")
+	raw.WriteString(http.StatusText(httpResp.StatusCode))
+	raw.WriteString("\r\n")
+
+	for key, values := range httpResp.Header {
+		switch strings.ToLower(key) {
+		case "content-length", "transfer-encoding", "trailer":
+			continue
+		}
+
+		for _, value := range values {
+			raw.WriteString(key)
+			raw.WriteString(": ")
+			raw.WriteString(value)
+			raw.WriteString("\r\n")
+		}
+	}
+
+	if httpResp.ContentLength >= 0 {
+		raw.WriteString("Content-Length: ")
+		raw.WriteString(strconv.FormatInt(httpResp.ContentLength, 10))
+		raw.WriteString("\r\n")
+	}
+
+	raw.WriteString("\r\n")
+
+	if err := header.Read(bufio.NewReader(&raw)); err != nil {
+		return fmt.Errorf("cannot convert the response header: %w", err)
+	}
+
+	return nil
+}
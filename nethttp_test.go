@@ -0,0 +1,155 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/s3hobby/client/pkg/signer"
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestNewNetHTTPRequest(t *testing.T) {
+	var req fasthttp.Request
+	req.Header.SetMethod(fasthttp.MethodPut)
+	req.SetRequestURI("https://bucket.s3.example.com/my%20key?versionId=1")
+	req.Header.DisableNormalizing()
+	req.Header.Set("x-amz-meta-CamelCase", "value")
+	req.Header.Set("X-Amz-Date", "20250101T000000Z")
+	require.NoError(t, req.Header.SetTrailer("x-amz-checksum-crc32"))
+	req.Header.Set("x-amz-checksum-crc32", "AAAAAA==")
+	req.SetBodyStream(strings.NewReader("stream"), -1)
+
+	httpReq, err := newNetHTTPRequest(&req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.MethodPut, httpReq.Method)
+	require.Equal(t, "https://bucket.s3.example.com/my%20key?versionId=1", httpReq.URL.String())
+	require.Equal(t, []string{"value"}, httpReq.Header["x-amz-meta-CamelCase"])
+	require.Equal(t, []string{"20250101T000000Z"}, httpReq.Header["X-Amz-Date"])
+	require.Equal(t, []string{""}, httpReq.Header["User-Agent"])
+	require.Equal(t, []string{"identity"}, httpReq.Header["Accept-Encoding"])
+	require.Equal(t, http.Header{"x-amz-checksum-crc32": {"AAAAAA=="}}, httpReq.Trailer)
+	require.NotContains(t, httpReq.Header, "x-amz-checksum-crc32")
+	require.Equal(t, int64(-1), httpReq.ContentLength)
+
+	body, err := io.ReadAll(httpReq.Body)
+	require.NoError(t, err)
+	require.Equal(t, "stream", string(body))
+
+	req.Reset()
+	req.SetRequestURI("http://bucket.s3.example.com/key")
+	req.Header.SetUserAgent("agent")
+	req.SetBody([]byte("content"))
+
+	httpReq, err = newNetHTTPRequest(&req)
+	require.NoError(t, err)
+	require.Equal(t, int64(len("content")), httpReq.ContentLength)
+	require.Equal(t, "agent", httpReq.Header.Get("User-Agent"))
+}
+
+func TestNetHTTPClient(t *testing.T) {
+	serverDate := time.Date(2030, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bucket/key", r.URL.Path)
+		assert.Equal(t, "identity", r.Header.Get("Accept-Encoding"))
+
+		w.Header().Set("Date", serverDate.Format(http.TimeFormat))
+		w.Header().Set("ETag", `"etag"`)
+
+		switch r.Method {
+		case http.MethodHead:
+			assert.Equal(t, DefaultUserAgent, r.Header.Get("User-Agent"))
+			w.Header().Set("Content-Length", "1234")
+		case http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "content", string(body))
+			assert.Equal(t, int64(len("content")), r.ContentLength)
+		case http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "stream", string(body))
+			assert.Equal(t, []string{"chunked"}, r.TransferEncoding)
+			assert.Empty(t, r.Header.Get("User-Agent"))
+			assert.Equal(t, "AAAAAA==", r.Trailer.Get("x-amz-checksum-crc32"))
+
+			w.Header().Set("Trailer", "X-Checksum")
+			_, err = w.Write([]byte("response"))
+			assert.NoError(t, err)
+			w.Header().Set("X-Checksum", "done")
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(&Options{
+		SiginingRegion: "dev-1",
+		EndpointHost:   strings.TrimPrefix(srv.URL, "http://"),
+		UsePathStyle:   true,
+		Signer:         signer.NewAnonymousSigner(),
+		HTTPClient:     NewNetHTTPClient(srv.Client()),
+	})
+	require.NoError(t, err)
+
+	head, metadata, err := c.HeadObject(t.Context(), &HeadObjectInput{Bucket: "bucket", Key: "key"})
+	require.NoError(t, err)
+	require.Equal(t, utils.ToPtr("1234"), head.ContentLength)
+	require.Equal(t, serverDate.Format(http.TimeFormat), string(metadata.Response.Header.Peek(HeaderDate)))
+
+	put, _, err := c.PutObject(t.Context(), &PutObjectInput{Bucket: "bucket", Key: "key", Body: []byte("content")})
+	require.NoError(t, err)
+	require.Equal(t, utils.ToPtr(`"etag"`), put.ETag)
+
+	var req fasthttp.Request
+	var resp fasthttp.Response
+	req.Header.SetMethod(fasthttp.MethodPost)
+	req.SetRequestURI(srv.URL + "/bucket/key")
+	require.NoError(t, req.Header.SetTrailer("x-amz-checksum-crc32"))
+	req.Header.Set("x-amz-checksum-crc32", "AAAAAA==")
+	req.SetBodyStream(strings.NewReader("stream"), -1)
+
+	require.NoError(t, NewNetHTTPClient(srv.Client()).Do(&req, &resp))
+	require.Equal(t, fasthttp.StatusOK, resp.StatusCode())
+	require.Equal(t, "response", string(resp.Body()))
+	require.Equal(t, "done", string(resp.Header.Peek("X-Checksum")))
+
+	streaming := NewNetHTTPClient(srv.Client())
+	streaming.SetStreamResponseBody(true)
+
+	req.SetBodyStream(strings.NewReader("stream"), -1)
+	resp.Reset()
+	require.NoError(t, streaming.Do(&req, &resp))
+	require.True(t, resp.IsBodyStream())
+	require.Equal(t, "response", string(resp.Body()))
+}
+
+func TestNetHTTPClient_redirect(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/elsewhere" {
+			assert.Fail(t, "redirection followed")
+			return
+		}
+
+		http.Redirect(w, r, "/elsewhere", http.StatusTemporaryRedirect)
+	}))
+	defer srv.Close()
+
+	httpClient := srv.Client()
+
+	var req fasthttp.Request
+	var resp fasthttp.Response
+	req.SetRequestURI(srv.URL + "/bucket/key")
+
+	require.NoError(t, NewNetHTTPClient(httpClient).Do(&req, &resp))
+	require.Equal(t, fasthttp.StatusTemporaryRedirect, resp.StatusCode())
+	require.Equal(t, "/elsewhere", string(resp.Header.Peek(HeaderLocation)))
+	require.Nil(t, httpClient.CheckRedirect, "the given client must not be modified")
+}
@@ -1,6 +1,9 @@
 package client
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/s3hobby/client/pkg/signer"
 
 	"github.com/go-playground/validator/v10"
@@ -29,6 +32,10 @@ type Options struct {
 
 	Credentials *signer.Credentials
 
+	// CredentialsProvider takes precedence over Credentials when set.
+	// Wrap it in a [CredentialsCache] to avoid retrieving the credentials for each request.
+	CredentialsProvider CredentialsProvider
+
 	// HTTPClient default to [DefaultHTTPClient].
 	HTTPClient HTTPClient `validate:"required"`
 }
@@ -59,6 +66,20 @@ func (opts *Options) setDefaults() {
 	}
 }
 
+// retrieveCredentials returns the credentials signing the requests, nil for anonymous requests.
+func (opts *Options) retrieveCredentials(ctx context.Context) (*signer.Credentials, error) {
+	if opts.CredentialsProvider == nil {
+		return opts.Credentials, nil
+	}
+
+	credentials, err := opts.CredentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve credentials: %w", err)
+	}
+
+	return credentials, nil
+}
+
 func (opts *Options) validate() error {
 	return validator.New(validator.WithRequiredStructEnabled()).Struct(opts)
 }
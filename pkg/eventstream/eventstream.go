@@ -0,0 +1,305 @@
+// Package eventstream implements the AWS event stream binary format
+// used by streaming operations such as SelectObjectContent.
+//
+// Each message is framed as follows (integers are big-endian):
+//
+//	total length (4) | headers length (4) | prelude CRC (4) | headers | payload | message CRC (4)
+//
+// Both CRC are CRC32 (IEEE), the prelude CRC covers the first 8 bytes
+// and the message CRC covers everything but itself.
+package eventstream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"time"
+)
+
+const preludeLen = 8
+const preludeCRCLen = 4
+const messageCRCLen = 4
+const minMessageLen = preludeLen + preludeCRCLen + messageCRCLen
+
+// MaxMessageLen is the maximum length of a message, headers included.
+const MaxMessageLen = 16 * 1024 * 1024
+
+// MaxHeadersLen is the maximum length of the headers of a message.
+const MaxHeadersLen = 128 * 1024
+
+var ErrPreludeChecksum = errors.New("eventstream: prelude checksum mismatch")
+var ErrMessageChecksum = errors.New("eventstream: message checksum mismatch")
+
+type HeaderType uint8
+
+const (
+	HeaderTypeBoolTrue  HeaderType = 0
+	HeaderTypeBoolFalse HeaderType = 1
+	HeaderTypeByte      HeaderType = 2
+	HeaderTypeInt16     HeaderType = 3
+	HeaderTypeInt32     HeaderType = 4
+	HeaderTypeInt64     HeaderType = 5
+	HeaderTypeBytes     HeaderType = 6
+	HeaderTypeString    HeaderType = 7
+	HeaderTypeTimestamp HeaderType = 8
+	HeaderTypeUUID      HeaderType = 9
+)
+
+// Header value is one of: bool, int8, int16, int32, int64, []byte, string,
+// time.Time (millisecond precision) or [16]byte (UUID).
+type Header struct {
+	Name  string
+	Value any
+}
+
+type Headers []Header
+
+// Get returns the value of the first header with the given name, nil if absent.
+func (h Headers) Get(name string) any {
+	for _, header := range h {
+		if header.Name == name {
+			return header.Value
+		}
+	}
+
+	return nil
+}
+
+// GetString returns the value of a string header, an empty string if absent.
+func (h Headers) GetString(name string) string {
+	v, _ := h.Get(name).(string)
+	return v
+}
+
+type Message struct {
+	Headers Headers
+	Payload []byte
+}
+
+type Decoder struct {
+	r       io.Reader
+	prelude [preludeLen + preludeCRCLen]byte
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Decode reads the next message.
+// io.EOF is returned when the stream ends on a message boundary.
+func (d *Decoder) Decode() (*Message, error) {
+	if _, err := io.ReadFull(d.r, d.prelude[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("eventstream: truncated prelude: %w", err)
+		}
+		return nil, err
+	}
+
+	totalLen := binary.BigEndian.Uint32(d.prelude[0:4])
+	headersLen := binary.BigEndian.Uint32(d.prelude[4:8])
+	preludeCRC := binary.BigEndian.Uint32(d.prelude[8:12])
+
+	if crc32.ChecksumIEEE(d.prelude[:preludeLen]) != preludeCRC {
+		return nil, ErrPreludeChecksum
+	}
+
+	if totalLen < minMessageLen || totalLen > MaxMessageLen {
+		return nil, fmt.Errorf("eventstream: invalid message length: %d", totalLen)
+	}
+
+	if headersLen > MaxHeadersLen || headersLen > totalLen-minMessageLen {
+		return nil, fmt.Errorf("eventstream: invalid headers length: %d", headersLen)
+	}
+
+	remaining := make([]byte, totalLen-uint32(len(d.prelude)))
+	if _, err := io.ReadFull(d.r, remaining); err != nil {
+		return nil, fmt.Errorf("eventstream: truncated message: %w", err)
+	}
+
+	data := remaining[:len(remaining)-messageCRCLen]
+	messageCRC := binary.BigEndian.Uint32(remaining[len(data):])
+
+	crc := crc32.Update(crc32.ChecksumIEEE(d.prelude[:]), crc32.IEEETable, data)
+	if crc != messageCRC {
+		return nil, ErrMessageChecksum
+	}
+
+	headers, err := decodeHeaders(data[:headersLen])
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		Headers: headers,
+		Payload: data[headersLen:],
+	}, nil
+}
+
+func decodeHeaders(b []byte) (Headers, error) {
+	var ret Headers
+
+	for len(b) > 0 {
+		nameLen := int(b[0])
+		b = b[1:]
+		if len(b) < nameLen+1 {
+			return nil, errors.New("eventstream: truncated header name")
+		}
+
+		name := string(b[:nameLen])
+		headerType := HeaderType(b[nameLen])
+		b = b[nameLen+1:]
+
+		value, n, err := decodeHeaderValue(headerType, b)
+		if err != nil {
+			return nil, fmt.Errorf("eventstream: header %q: %w", name, err)
+		}
+		b = b[n:]
+
+		ret = append(ret, Header{Name: name, Value: value})
+	}
+
+	return ret, nil
+}
+
+var fixedValueLen = map[HeaderType]int{
+	HeaderTypeByte:      1,
+	HeaderTypeInt16:     2,
+	HeaderTypeInt32:     4,
+	HeaderTypeInt64:     8,
+	HeaderTypeTimestamp: 8,
+	HeaderTypeUUID:      16,
+}
+
+func decodeHeaderValue(headerType HeaderType, b []byte) (any, int, error) {
+	switch headerType {
+	case HeaderTypeBoolTrue:
+		return true, 0, nil
+	case HeaderTypeBoolFalse:
+		return false, 0, nil
+	case HeaderTypeBytes, HeaderTypeString:
+		if len(b) < 2 {
+			return nil, 0, errors.New("truncated value length")
+		}
+		valueLen := int(binary.BigEndian.Uint16(b))
+		if len(b) < 2+valueLen {
+			return nil, 0, errors.New("truncated value")
+		}
+		value := b[2 : 2+valueLen]
+		if headerType == HeaderTypeString {
+			return string(value), 2 + valueLen, nil
+		}
+		return bytes.Clone(value), 2 + valueLen, nil
+	}
+
+	n, known := fixedValueLen[headerType]
+	if !known {
+		return nil, 0, fmt.Errorf("unknown value type: %d", headerType)
+	}
+
+	if len(b) < n {
+		return nil, 0, errors.New("truncated value")
+	}
+
+	switch headerType {
+	case HeaderTypeByte:
+		return int8(b[0]), n, nil
+	case HeaderTypeInt16:
+		return int16(binary.BigEndian.Uint16(b)), n, nil
+	case HeaderTypeInt32:
+		return int32(binary.BigEndian.Uint32(b)), n, nil
+	case HeaderTypeInt64:
+		return int64(binary.BigEndian.Uint64(b)), n, nil
+	case HeaderTypeTimestamp:
+		return time.UnixMilli(int64(binary.BigEndian.Uint64(b))).UTC(), n, nil
+	default: // HeaderTypeUUID
+		return [16]byte(b[:n]), n, nil
+	}
+}
+
+// Encode writes msg to w.
+func Encode(w io.Writer, msg *Message) error {
+	var headers bytes.Buffer
+	for _, header := range msg.Headers {
+		if err := encodeHeader(&headers, header); err != nil {
+			return err
+		}
+	}
+
+	if headers.Len() > MaxHeadersLen {
+		return fmt.Errorf("eventstream: headers too long: %d", headers.Len())
+	}
+
+	totalLen := minMessageLen + headers.Len() + len(msg.Payload)
+	if totalLen > MaxMessageLen {
+		return fmt.Errorf("eventstream: message too long: %d", totalLen)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(totalLen)
+	_ = binary.Write(&buf, binary.BigEndian, uint32(totalLen))      //nolint:gosec // bounded by MaxMessageLen
+	_ = binary.Write(&buf, binary.BigEndian, uint32(headers.Len())) //nolint:gosec // bounded by MaxHeadersLen
+	_ = binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(buf.Bytes()))
+	buf.Write(headers.Bytes())
+	buf.Write(msg.Payload)
+	_ = binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(buf.Bytes()))
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func encodeHeader(buf *bytes.Buffer, header Header) error {
+	if len(header.Name) > 255 {
+		return fmt.Errorf("eventstream: header name too long: %q", header.Name)
+	}
+
+	buf.WriteByte(byte(len(header.Name)))
+	buf.WriteString(header.Name)
+
+	writeVariable := func(headerType HeaderType, value []byte) error {
+		if len(value) > 65535 {
+			return fmt.Errorf("eventstream: header %q value too long", header.Name)
+		}
+		buf.WriteByte(byte(headerType))
+		_ = binary.Write(buf, binary.BigEndian, uint16(len(value))) //nolint:gosec // checked above
+		buf.Write(value)
+		return nil
+	}
+
+	switch v := header.Value.(type) {
+	case bool:
+		if v {
+			buf.WriteByte(byte(HeaderTypeBoolTrue))
+		} else {
+			buf.WriteByte(byte(HeaderTypeBoolFalse))
+		}
+	case int8:
+		buf.WriteByte(byte(HeaderTypeByte))
+		buf.WriteByte(byte(v))
+	case int16:
+		buf.WriteByte(byte(HeaderTypeInt16))
+		_ = binary.Write(buf, binary.BigEndian, v)
+	case int32:
+		buf.WriteByte(byte(HeaderTypeInt32))
+		_ = binary.Write(buf, binary.BigEndian, v)
+	case int64:
+		buf.WriteByte(byte(HeaderTypeInt64))
+		_ = binary.Write(buf, binary.BigEndian, v)
+	case []byte:
+		return writeVariable(HeaderTypeBytes, v)
+	case string:
+		return writeVariable(HeaderTypeString, []byte(v))
+	case time.Time:
+		buf.WriteByte(byte(HeaderTypeTimestamp))
+		_ = binary.Write(buf, binary.BigEndian, v.UnixMilli())
+	case [16]byte:
+		buf.WriteByte(byte(HeaderTypeUUID))
+		buf.Write(v[:])
+	default:
+		return fmt.Errorf("eventstream: header %q: unsupported value type %T", header.Name, header.Value)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package eventstream
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Vectors from the AWS event stream test suite.
+var testVectors = []struct {
+	name     string
+	encoded  string
+	expected *Message
+}{
+	{
+		name:     "empty_message",
+		encoded:  "000000100000000005c248eb7d98c8ff",
+		expected: &Message{Payload: []byte{}},
+	},
+	{
+		name:     "payload_no_headers",
+		encoded:  "0000001d00000000fd528c5a7b27666f6f273a27626172277dc3653936",
+		expected: &Message{Payload: []byte("{'foo':'bar'}")},
+	},
+}
+
+func TestDecoder(t *testing.T) {
+	for _, tc := range testVectors {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded, err := hex.DecodeString(tc.encoded)
+			require.NoError(t, err)
+
+			d := NewDecoder(bytes.NewReader(encoded))
+
+			actual, err := d.Decode()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+
+			_, err = d.Decode()
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+
+	t.Run("prelude checksum", func(t *testing.T) {
+		encoded, err := hex.DecodeString(testVectors[1].encoded)
+		require.NoError(t, err)
+		encoded[8] ^= 0xff
+
+		_, err = NewDecoder(bytes.NewReader(encoded)).Decode()
+		require.ErrorIs(t, err, ErrPreludeChecksum)
+	})
+
+	t.Run("message checksum", func(t *testing.T) {
+		encoded, err := hex.DecodeString(testVectors[1].encoded)
+		require.NoError(t, err)
+		encoded[14] ^= 0xff
+
+		_, err = NewDecoder(bytes.NewReader(encoded)).Decode()
+		require.ErrorIs(t, err, ErrMessageChecksum)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		encoded, err := hex.DecodeString(testVectors[1].encoded)
+		require.NoError(t, err)
+
+		_, err = NewDecoder(bytes.NewReader(encoded[:20])).Decode()
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
+
+func TestEncode(t *testing.T) {
+	for _, tc := range testVectors {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Encode(&buf, tc.expected))
+			require.Equal(t, tc.encoded, hex.EncodeToString(buf.Bytes()))
+		})
+	}
+
+	t.Run("all header types", func(t *testing.T) {
+		expected := &Message{
+			Headers: Headers{
+				{Name: "true", Value: true},
+				{Name: "false", Value: false},
+				{Name: "byte", Value: int8(-42)},
+				{Name: "int16", Value: int16(-4242)},
+				{Name: "int32", Value: int32(424242)},
+				{Name: "int64", Value: int64(42424242424242)},
+				{Name: "bytes", Value: []byte{0, 1, 2}},
+				{Name: ":event-type", Value: "Records"},
+				{Name: "timestamp", Value: time.UnixMilli(1234567890123).UTC()},
+				{Name: "uuid", Value: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}},
+			},
+			Payload: []byte("payload"),
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, expected))
+
+		actual, err := NewDecoder(&buf).Decode()
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+		require.Equal(t, "Records", actual.Headers.GetString(":event-type"))
+		require.Empty(t, actual.Headers.GetString("missing"))
+	})
+}
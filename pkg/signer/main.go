@@ -9,6 +9,12 @@ import (
 type Credentials struct {
 	AccessKeyID     string
 	SecretAccessKey string
+
+	// SessionToken is set for temporary credentials, and sent as X-Amz-Security-Token.
+	SessionToken string
+
+	// Expires is the expiration of temporary credentials, zero when they never expire.
+	Expires time.Time
 }
 
 type Signer interface {
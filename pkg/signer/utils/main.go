@@ -4,10 +4,11 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
-	"fmt"
 	"strings"
 )
 
+const upperHex = "0123456789ABCDEF"
+
 // LowerCase implement AWS definition:
 // Convert the string to lowercase.
 func LowerCase(v string) string {
@@ -48,7 +49,19 @@ func Trim(v string) string {
 // Letters in the hexadecimal value must be uppercase, for example "%1A".
 // Encode the forward slash character, '/', everywhere except in the object key name. For example, if the object key name is photos/Jan/sample.jpg, the forward slash in the key name is not encoded.
 func URIEncode(v string, isObjectKey bool) string {
+	escaped := 0
+	for i := range len(v) {
+		if shouldEscape(v[i], isObjectKey) {
+			escaped++
+		}
+	}
+
+	if escaped == 0 {
+		return v
+	}
+
 	var ret strings.Builder
+	ret.Grow(len(v) + 2*escaped)
 
 	for i := range len(v) {
 		c := v[i]
@@ -57,12 +70,41 @@ func URIEncode(v string, isObjectKey bool) string {
 			continue
 		}
 
-		ret.WriteString(fmt.Sprintf("%%%02X", c))
+		ret.WriteByte('%')
+		ret.WriteByte(upperHex[c>>4])
+		ret.WriteByte(upperHex[c&0x0F])
 	}
 
 	return ret.String()
 }
 
+// AppendURIEncode appends the URIEncode of v to dst and returns the extended buffer.
+func AppendURIEncode[T string | []byte](dst []byte, v T, isObjectKey bool) []byte {
+	for i := range len(v) {
+		c := v[i]
+		if !shouldEscape(c, isObjectKey) {
+			dst = append(dst, c)
+			continue
+		}
+
+		dst = append(dst, '%', upperHex[c>>4], upperHex[c&0x0F])
+	}
+
+	return dst
+}
+
+// AppendLowerCase appends the ASCII lowercase of v to dst and returns the extended buffer.
+func AppendLowerCase(dst []byte, v []byte) []byte {
+	for _, c := range v {
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+
+	return dst
+}
+
 func shouldEscape(v byte, isObjectKey bool) bool {
 	if v >= 'A' && v <= 'Z' ||
 		v >= 'a' && v <= 'z' ||
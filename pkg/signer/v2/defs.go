@@ -0,0 +1,15 @@
+package v2
+
+// FormatDate is the RFC 1123 format of the Date and x-amz-date headers.
+const FormatDate = "Mon, 02 Jan 2006 15:04:05 -0700"
+
+const HeaderAuthorization = "Authorization"
+const HeaderContentMD5 = "Content-MD5"
+const HeaderDate = "Date"
+const HeaderXAmzDate = "x-amz-date"
+const HeaderXAmzSecurityToken = "x-amz-security-token"
+
+const QueryAWSAccessKeyID = "AWSAccessKeyId"
+const QueryExpires = "Expires"
+const QuerySignature = "Signature"
+const QueryXAmzSecurityToken = "x-amz-security-token"
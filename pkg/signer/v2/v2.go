@@ -0,0 +1,259 @@
+// Package v2 implements the legacy AWS Signature Version 2,
+// still required by some S3-compatible storage appliances.
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/RESTAuthentication.html
+package v2
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // SigV2 is specified with HMAC-SHA1
+	"encoding/base64"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/s3hobby/client/pkg/signer"
+	"github.com/s3hobby/client/pkg/signer/utils"
+
+	"github.com/valyala/fasthttp"
+)
+
+// subResources are the query parameters included in the canonicalized resource.
+var subResources = map[string]struct{}{
+	"acl":                          {},
+	"analytics":                    {},
+	"attributes":                   {},
+	"cors":                         {},
+	"delete":                       {},
+	"encryption":                   {},
+	"intelligent-tiering":          {},
+	"inventory":                    {},
+	"legal-hold":                   {},
+	"lifecycle":                    {},
+	"location":                     {},
+	"logging":                      {},
+	"metrics":                      {},
+	"notification":                 {},
+	"object-lock":                  {},
+	"ownershipControls":            {},
+	"partNumber":                   {},
+	"policy":                       {},
+	"policyStatus":                 {},
+	"publicAccessBlock":            {},
+	"replication":                  {},
+	"requestPayment":               {},
+	"response-cache-control":       {},
+	"response-content-disposition": {},
+	"response-content-encoding":    {},
+	"response-content-language":    {},
+	"response-content-type":        {},
+	"response-expires":             {},
+	"restore":                      {},
+	"retention":                    {},
+	"select":                       {},
+	"select-type":                  {},
+	"tagging":                      {},
+	"torrent":                      {},
+	"uploadId":                     {},
+	"uploads":                      {},
+	"versionId":                    {},
+	"versioning":                   {},
+	"versions":                     {},
+	"website":                      {},
+}
+
+var _ signer.Signer = (*HeaderSigner)(nil)
+
+// HeaderSigner signs the requests with the Authorization header.
+type HeaderSigner struct {
+	endpointHost string
+}
+
+// NewHeaderSigner returns a SigV2 signer.
+// The endpointHost, e.g. [client.Options.EndpointHost], is used to extract the
+// bucket of the virtual-hosted style requests. Path style is assumed when empty.
+func NewHeaderSigner(endpointHost string) *HeaderSigner {
+	return &HeaderSigner{
+		endpointHost: endpointHost,
+	}
+}
+
+// Sign sets the Date and Authorization headers.
+// The region is ignored and the canonical request is always empty with SigV2.
+func (s *HeaderSigner) Sign(req *fasthttp.Request, credentials *signer.Credentials, _ string, now time.Time) (canonicalRequest string, stringToSign string, err error) {
+	req.Header.Del(HeaderAuthorization)
+
+	date := now.UTC().Format(FormatDate)
+	if len(req.Header.Peek(HeaderXAmzDate)) > 0 {
+		// x-amz-date is part of the canonicalized amz headers
+		req.Header.Set(HeaderXAmzDate, date)
+		req.Header.Del(HeaderDate)
+		date = ""
+	} else {
+		req.Header.Set(HeaderDate, date)
+	}
+
+	if credentials.SessionToken != "" {
+		req.Header.Set(HeaderXAmzSecurityToken, credentials.SessionToken)
+	}
+
+	stringToSign = computeStringToSign(req, s.endpointHost, date, "")
+
+	req.Header.Set(HeaderAuthorization, "AWS "+credentials.AccessKeyID+":"+computeSignature(credentials, stringToSign))
+
+	return "", stringToSign, nil
+}
+
+var _ signer.Signer = (*QuerySigner)(nil)
+
+// QuerySigner presigns the requests with the query string,
+// the resulting URL is valid until the expiration.
+type QuerySigner struct {
+	endpointHost string
+	expiresIn    time.Duration
+}
+
+// NewQuerySigner returns a SigV2 presigner.
+// The endpointHost has the same meaning than with [NewHeaderSigner].
+func NewQuerySigner(endpointHost string, expiresIn time.Duration) *QuerySigner {
+	return &QuerySigner{
+		endpointHost: endpointHost,
+		expiresIn:    expiresIn,
+	}
+}
+
+// Sign sets the AWSAccessKeyId, Expires and Signature query parameters.
+// The region is ignored and the canonical request is always empty with SigV2.
+func (s *QuerySigner) Sign(req *fasthttp.Request, credentials *signer.Credentials, _ string, now time.Time) (canonicalRequest string, stringToSign string, err error) {
+	args := req.URI().QueryArgs()
+	args.Del(QueryAWSAccessKeyID)
+	args.Del(QueryExpires)
+	args.Del(QuerySignature)
+	args.Del(QueryXAmzSecurityToken)
+
+	expires := strconv.FormatInt(now.Add(s.expiresIn).Unix(), 10)
+
+	// The session token is signed as an amz header but sent in the query string
+	stringToSign = computeStringToSign(req, s.endpointHost, expires, credentials.SessionToken)
+
+	args.Set(QueryAWSAccessKeyID, credentials.AccessKeyID)
+	args.Set(QueryExpires, expires)
+	args.Set(QuerySignature, computeSignature(credentials, stringToSign))
+	if credentials.SessionToken != "" {
+		args.Set(QueryXAmzSecurityToken, credentials.SessionToken)
+	}
+
+	return "", stringToSign, nil
+}
+
+func computeSignature(credentials *signer.Credentials, stringToSign string) string {
+	mac := hmac.New(sha1.New, []byte(credentials.SecretAccessKey))
+	mac.Write([]byte(stringToSign))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// computeStringToSign returns:
+//
+//	HTTP-Verb + "\n" +
+//	Content-MD5 + "\n" +
+//	Content-Type + "\n" +
+//	Date + "\n" +
+//	CanonicalizedAmzHeaders +
+//	CanonicalizedResource
+func computeStringToSign(req *fasthttp.Request, endpointHost, date, securityToken string) string {
+	if len(req.Header.ContentType()) == 0 {
+		// Otherwise fasthttp sends a default unsigned content type along with the body
+		req.Header.SetNoDefaultContentType(true)
+	}
+
+	var ret strings.Builder
+
+	ret.Write(req.Header.Method())
+	ret.WriteByte('\n')
+
+	ret.Write(req.Header.Peek(HeaderContentMD5))
+	ret.WriteByte('\n')
+
+	ret.Write(req.Header.ContentType())
+	ret.WriteByte('\n')
+
+	ret.WriteString(date)
+	ret.WriteByte('\n')
+
+	ret.WriteString(canonicalizedAmzHeaders(req, securityToken))
+	ret.WriteString(canonicalizedResource(req, endpointHost))
+
+	return ret.String()
+}
+
+func canonicalizedAmzHeaders(req *fasthttp.Request, securityToken string) string {
+	headers := map[string][]string{}
+	if securityToken != "" {
+		headers[HeaderXAmzSecurityToken] = []string{securityToken}
+	}
+
+	req.Header.VisitAll(func(key, value []byte) {
+		normalizedKey := utils.LowerCase(string(key))
+		if !strings.HasPrefix(normalizedKey, "x-amz-") {
+			return
+		}
+
+		headers[normalizedKey] = append(headers[normalizedKey], utils.Trim(string(value)))
+	})
+
+	var ret strings.Builder
+
+	for _, key := range slices.Sorted(maps.Keys(headers)) {
+		ret.WriteString(key)
+		ret.WriteByte(':')
+		ret.WriteString(strings.Join(headers[key], ","))
+		ret.WriteByte('\n')
+	}
+
+	return ret.String()
+}
+
+func canonicalizedResource(req *fasthttp.Request, endpointHost string) string {
+	var ret strings.Builder
+
+	host := string(req.Host())
+	if endpointHost != "" && host != endpointHost {
+		if bucket, found := strings.CutSuffix(host, "."+endpointHost); found {
+			ret.WriteByte('/')
+			ret.WriteString(bucket)
+		}
+	}
+
+	path := string(req.URI().PathOriginal())
+	if path == "" {
+		path = "/"
+	}
+	ret.WriteString(path)
+
+	params := map[string]string{}
+
+	req.URI().QueryArgs().VisitAll(func(key, value []byte) {
+		if _, exists := subResources[string(key)]; exists {
+			params[string(key)] = string(value)
+		}
+	})
+
+	for i, key := range slices.Sorted(maps.Keys(params)) {
+		if i == 0 {
+			ret.WriteByte('?')
+		} else {
+			ret.WriteByte('&')
+		}
+
+		ret.WriteString(key)
+		if value := params[key]; value != "" {
+			ret.WriteByte('=')
+			ret.WriteString(value)
+		}
+	}
+
+	return ret.String()
+}
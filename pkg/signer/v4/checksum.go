@@ -0,0 +1,41 @@
+package v4
+
+import (
+	"crypto/sha1" //nolint:gosec // SHA-1 is one of the S3 checksum algorithms
+	"crypto/sha256"
+	"encoding/base64"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+
+	"github.com/s3hobby/client/pkg/signer/utils"
+)
+
+// crc64NVMEPolynomial is the reversed polynomial of CRC-64/NVME.
+const crc64NVMEPolynomial = 0x9A6C9329AC4BC9B5
+
+var crc32CTable = crc32.MakeTable(crc32.Castagnoli)
+var crc64NVMETable = crc64.MakeTable(crc64NVMEPolynomial)
+
+// checksumAlgorithms computes the checksum trailers the caller did not set.
+var checksumAlgorithms = map[string]func() hash.Hash{
+	HeaderXAmzChecksumCRC32:     func() hash.Hash { return crc32.NewIEEE() },
+	HeaderXAmzChecksumCRC32C:    func() hash.Hash { return crc32.New(crc32CTable) },
+	HeaderXAmzChecksumCRC64NVME: func() hash.Hash { return crc64.New(crc64NVMETable) },
+	HeaderXAmzChecksumSHA1:      sha1.New,
+	HeaderXAmzChecksumSHA256:    sha256.New,
+}
+
+// computeChecksum returns the base64 encoded checksum of the payload
+// when name is a checksum header.
+func computeChecksum(name, payload []byte) ([]byte, bool) {
+	newHash, found := checksumAlgorithms[string(utils.AppendLowerCase(nil, name))]
+	if !found {
+		return nil, false
+	}
+
+	h := newHash()
+	h.Write(payload)
+
+	return base64.StdEncoding.AppendEncode(nil, h.Sum(nil)), true
+}
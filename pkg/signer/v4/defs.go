@@ -9,3 +9,26 @@ const HeaderXAmzContentSHA256 = "x-amz-content-sha256"
 const HeaderXAmzDecodedContentLength = "x-amz-decoded-content-length"
 const HeaderContentEncoding = "Content-Encoding"
 const HeaderAuthorization = "Authorization"
+const HeaderXAmzRegionSet = "x-amz-region-set"
+const HeaderXAmzSecurityToken = "x-amz-security-token"
+
+// FormatPostPolicyExpiration is the ISO 8601 format of the POST policy expiration.
+const FormatPostPolicyExpiration = "2006-01-02T15:04:05.000Z"
+
+const postPolicyContentLengthRange = "content-length-range"
+
+const FormFieldBucket = "bucket"
+const FormFieldContentType = "Content-Type"
+const FormFieldKey = "key"
+const FormFieldPolicy = "policy"
+const FormFieldXAmzAlgorithm = "x-amz-algorithm"
+const FormFieldXAmzCredential = "x-amz-credential"
+const FormFieldXAmzDate = "x-amz-date"
+const FormFieldXAmzSecurityToken = "x-amz-security-token"
+const FormFieldXAmzSignature = "x-amz-signature"
+
+const HeaderXAmzChecksumCRC32 = "x-amz-checksum-crc32"
+const HeaderXAmzChecksumCRC32C = "x-amz-checksum-crc32c"
+const HeaderXAmzChecksumCRC64NVME = "x-amz-checksum-crc64nvme"
+const HeaderXAmzChecksumSHA1 = "x-amz-checksum-sha1"
+const HeaderXAmzChecksumSHA256 = "x-amz-checksum-sha256"
@@ -0,0 +1,168 @@
+package v4
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/s3hobby/client/pkg/signer"
+)
+
+// PostPolicy builds and signs the policy of a browser-based upload using HTTP POST.
+//
+// The returned form fields must be sent along with the file, which must be
+// the last field of the multipart/form-data body.
+type PostPolicy struct {
+	expiration time.Time
+	bucket     string
+	conditions []any
+	fields     map[string]string
+
+	// namedConditions maps a form field name, or content-length-range,
+	// to the index of its condition
+	namedConditions map[string]int
+}
+
+// NewPostPolicy returns a POST policy for the bucket, valid until expiration.
+func NewPostPolicy(bucket string, expiration time.Time) *PostPolicy {
+	return &PostPolicy{
+		expiration:      expiration,
+		bucket:          bucket,
+		fields:          map[string]string{},
+		namedConditions: map[string]int{},
+	}
+}
+
+// Bucket returns the bucket of the policy.
+func (p *PostPolicy) Bucket() string {
+	return p.bucket
+}
+
+// SetKey restricts the upload to the exact key, replacing any previous key restriction.
+func (p *PostPolicy) SetKey(key string) *PostPolicy {
+	return p.setKey(key, map[string]string{FormFieldKey: key})
+}
+
+// SetKeyStartsWith restricts the upload to the keys starting with prefix,
+// replacing any previous key restriction.
+// The key field defaults to prefix followed by the name of the uploaded file.
+func (p *PostPolicy) SetKeyStartsWith(prefix string) *PostPolicy {
+	return p.setKey(prefix+"${filename}", []string{"starts-with", "$" + FormFieldKey, prefix})
+}
+
+// SetContentLengthRange restricts the size of the uploaded file, in bytes,
+// replacing any previous range.
+func (p *PostPolicy) SetContentLengthRange(minLength, maxLength int64) *PostPolicy {
+	return p.setCondition(postPolicyContentLengthRange, []any{postPolicyContentLengthRange, minLength, maxLength})
+}
+
+// SetContentType restricts the upload to the exact content type,
+// replacing any previous content type.
+func (p *PostPolicy) SetContentType(contentType string) *PostPolicy {
+	return p.SetField(FormFieldContentType, contentType)
+}
+
+// SetField sets a form field and the condition matching exactly its value,
+// replacing any previous value of the field.
+// The key field is set as with [PostPolicy.SetKey].
+func (p *PostPolicy) SetField(name, value string) *PostPolicy {
+	if name == FormFieldKey {
+		return p.SetKey(value)
+	}
+
+	p.fields[name] = value
+	return p.setCondition(name, map[string]string{name: value})
+}
+
+// AddCondition adds an arbitrary condition, marshaled as is in the policy, e.g.
+// []string{"starts-with", "$x-amz-meta-tag", ""}.
+// The matching form field is not added.
+func (p *PostPolicy) AddCondition(condition any) *PostPolicy {
+	p.conditions = append(p.conditions, condition)
+	return p
+}
+
+type postPolicyDocument struct {
+	Expiration string `json:"expiration"`
+	Conditions []any  `json:"conditions"`
+}
+
+// Sign returns the form fields of the upload, including the base64 encoded
+// policy and its SigV4 signature.
+func (p *PostPolicy) Sign(credentials *signer.Credentials, region string, now time.Time) (map[string]string, error) {
+	// AWS S3 specify the use of UTC time
+	now = now.UTC()
+
+	if p.bucket == "" {
+		return nil, errors.New("POST policy: bucket is mandatory")
+	}
+
+	if p.fields[FormFieldKey] == "" {
+		return nil, errors.New("POST policy: key is mandatory")
+	}
+
+	if !p.expiration.After(now) {
+		return nil, errors.New("POST policy: already expired")
+	}
+
+	algorithm := newHMACAlgorithm(credentials, region, DefaultService, now)
+
+	fields := maps.Clone(p.fields)
+	fields[FormFieldXAmzAlgorithm] = algorithm.Name()
+	fields[FormFieldXAmzCredential] = credentials.AccessKeyID + "/" + algorithm.Scope()
+	fields[FormFieldXAmzDate] = now.Format(FormatXAmzDate)
+	if credentials.SessionToken != "" {
+		fields[FormFieldXAmzSecurityToken] = credentials.SessionToken
+	}
+
+	conditions := slices.Clone(p.conditions)
+	conditions = append(conditions,
+		map[string]string{FormFieldBucket: p.bucket},
+		map[string]string{FormFieldXAmzAlgorithm: fields[FormFieldXAmzAlgorithm]},
+		map[string]string{FormFieldXAmzCredential: fields[FormFieldXAmzCredential]},
+		map[string]string{FormFieldXAmzDate: fields[FormFieldXAmzDate]},
+	)
+	if credentials.SessionToken != "" {
+		conditions = append(conditions, map[string]string{FormFieldXAmzSecurityToken: credentials.SessionToken})
+	}
+
+	document, err := json.Marshal(&postPolicyDocument{
+		Expiration: p.expiration.UTC().Format(FormatPostPolicyExpiration),
+		Conditions: conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policy := base64.StdEncoding.EncodeToString(document)
+
+	signature, err := algorithm.Sign(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	fields[FormFieldPolicy] = policy
+	fields[FormFieldXAmzSignature] = signature
+
+	return fields, nil
+}
+
+// setKey sets the key field and its condition.
+func (p *PostPolicy) setKey(field string, condition any) *PostPolicy {
+	p.fields[FormFieldKey] = field
+	return p.setCondition(FormFieldKey, condition)
+}
+
+// setCondition sets the named condition in place of the previous one if any.
+func (p *PostPolicy) setCondition(name string, condition any) *PostPolicy {
+	if i, ok := p.namedConditions[name]; ok {
+		p.conditions[i] = condition
+		return p
+	}
+
+	p.namedConditions[name] = len(p.conditions)
+	return p.AddCondition(condition)
+}
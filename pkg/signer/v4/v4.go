@@ -2,12 +2,14 @@ package v4
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"maps"
 	"slices"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/s3hobby/client/pkg/signer"
@@ -16,17 +18,146 @@ import (
 	"github.com/valyala/fasthttp"
 )
 
-const signatureValueLen = 64
-const chunkDataSize = 64 * 1024
+// DefaultChunkSize is the size of the aws-chunked chunks, but the last one.
+const DefaultChunkSize = 64 * 1024
+
+// DefaultService is the signing name of S3, the service signed by default.
+const DefaultService = "s3"
+
+// MinChunkSize is the minimal size of the aws-chunked chunks, but the last one, accepted by S3.
+const MinChunkSize = 8 * 1024
 
 var crlf = []byte{'\r', '\n'}
 var trailerKeyValueSeparator = []byte{':'}
 
 var emptyHash = utils.Hex(utils.SHA256Hash(nil))
 
+// signingAlgorithm abstracts the differences between SigV4 and SigV4a.
+type signingAlgorithm interface {
+	// Name is the algorithm of the Authorization header and the prefix of
+	// the chunk and trailer string to sign.
+	Name() string
+	Scope() string
+	Sign(stringToSign string) (string, error)
+
+	// ChunkSignature returns the signature as written in the chunk
+	// metadata, which must have a length of ChunkSignatureLen.
+	ChunkSignature(signature string) string
+	ChunkSignatureLen() int
+}
+
+var _ signingAlgorithm = (*hmacAlgorithm)(nil)
+
+type hmacAlgorithm struct {
+	scope      string
+	signingKey []byte
+}
+
+func newHMACAlgorithm(credentials *signer.Credentials, region, service string, now time.Time) *hmacAlgorithm {
+	date := now.Format(FormatYYYYMMDD)
+
+	dateKey := utils.HMAC_SHA256([]byte("AWS4"+credentials.SecretAccessKey), []byte(date))
+	dateRegionKey := utils.HMAC_SHA256(dateKey, []byte(region))
+	dateRegionServiceKey := utils.HMAC_SHA256(dateRegionKey, []byte(service))
+
+	return &hmacAlgorithm{
+		scope:      date + "/" + region + "/" + service + "/aws4_request",
+		signingKey: utils.HMAC_SHA256(dateRegionServiceKey, []byte("aws4_request")),
+	}
+}
+
+func (*hmacAlgorithm) Name() string {
+	return "AWS4-HMAC-SHA256"
+}
+
+func (a *hmacAlgorithm) Scope() string {
+	return a.scope
+}
+
+func (a *hmacAlgorithm) Sign(stringToSign string) (string, error) {
+	return utils.Hex(utils.HMAC_SHA256(a.signingKey, []byte(stringToSign))), nil
+}
+
+func (*hmacAlgorithm) ChunkSignature(signature string) string {
+	return signature
+}
+
+func (*hmacAlgorithm) ChunkSignatureLen() int {
+	return hex.EncodedLen(sha256.Size)
+}
+
+// maxSigningKeyCacheLen bounds the number of credentials, region and service triples cached for a day.
+const maxSigningKeyCacheLen = 64
+
+// maxSigningKeyCacheDates bounds the number of days cached, the clock skew of the hosts
+// may require the signing keys of the previous or of the next day around midnight.
+const maxSigningKeyCacheDates = 3
+
+// signingKeyCacheKey only holds the credentials used by the derivation,
+// not the session token nor the expiration which change with each refresh.
+type signingKeyCacheKey struct {
+	accessKeyID     string
+	secretAccessKey string
+	region          string
+	service         string
+}
+
+// signingKeyCache saves the four HMAC of the signing key derivation,
+// the derived key only changes with the credentials, the region, the service and the day.
+type signingKeyCache struct {
+	mu    sync.Mutex
+	dates map[string]map[signingKeyCacheKey]*hmacAlgorithm
+}
+
+func (c *signingKeyCache) get(credentials *signer.Credentials, region, service string, now time.Time) *hmacAlgorithm {
+	var dateBuf [len(FormatYYYYMMDD)]byte
+	date := now.AppendFormat(dateBuf[:0], FormatYYYYMMDD)
+
+	key := signingKeyCacheKey{
+		accessKeyID:     credentials.AccessKeyID,
+		secretAccessKey: credentials.SecretAccessKey,
+		region:          region,
+		service:         service,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	algorithms := c.dates[string(date)]
+	if algorithm, found := algorithms[key]; found {
+		return algorithm
+	}
+
+	if algorithms == nil || len(algorithms) >= maxSigningKeyCacheLen {
+		algorithms = c.newDate(string(date))
+	}
+
+	algorithm := newHMACAlgorithm(credentials, region, service, now)
+	algorithms[key] = algorithm
+
+	return algorithm
+}
+
+// newDate resets the algorithms of the date, evicting the oldest date when the cache is full.
+func (c *signingKeyCache) newDate(date string) map[signingKeyCacheKey]*hmacAlgorithm {
+	if c.dates == nil {
+		c.dates = make(map[string]map[signingKeyCacheKey]*hmacAlgorithm, maxSigningKeyCacheDates)
+	}
+
+	if _, found := c.dates[date]; !found && len(c.dates) >= maxSigningKeyCacheDates {
+		// The dates are formatted as YYYYMMDD, the oldest is the lowest
+		delete(c.dates, slices.Min(slices.Collect(maps.Keys(c.dates))))
+	}
+
+	algorithms := make(map[signingKeyCacheKey]*hmacAlgorithm)
+	c.dates[date] = algorithms
+
+	return algorithms
+}
+
 type payloadTransformer interface {
 	Prepare() error
-	Transform(seed, date, scope string, signingKey []byte)
+	Transform(seed, date string, algorithm signingAlgorithm) error
 }
 
 var _ payloadTransformer = (*plainTransformer)(nil)
@@ -46,14 +177,33 @@ func (t *plainTransformer) Prepare() error {
 	return nil
 }
 
-func (t *plainTransformer) Transform(_, _, _ string, _ []byte) {}
+func (t *plainTransformer) Transform(_, _ string, _ signingAlgorithm) error {
+	return nil
+}
+
+// trailer is a header sent after the aws-chunked payload.
+type trailer struct {
+	name  []byte
+	value []byte
+}
+
+// lineLen is the length of the "name:value" trailer line, without the line ending.
+func (t *trailer) lineLen() int {
+	return len(t.name) + len(trailerKeyValueSeparator) + len(t.value)
+}
 
 type streamTransformer struct {
-	req          *fasthttp.Request
-	buf          bytes.Buffer
-	signBody     bool
-	trailerName  []byte
-	trailerValue []byte
+	req       *fasthttp.Request
+	buf       bytes.Buffer
+	signBody  bool
+	trailers  []trailer
+	chunkSize int
+
+	// algorithmName is the signing algorithm used in the streaming content SHA256.
+	algorithmName string
+
+	// signatureLen is the length of the chunk signatures.
+	signatureLen int
 }
 
 func (t *streamTransformer) Prepare() error {
@@ -64,7 +214,9 @@ func (t *streamTransformer) Prepare() error {
 
 	newLen := t.transformedBodyLen()
 	t.buf.Grow(newLen)
-	t.req.Header.DelBytes(t.trailerName)
+	for _, trailer := range t.trailers {
+		t.req.Header.DelBytes(trailer.name)
+	}
 	t.req.Header.Set(HeaderXAmzContentSHA256, t.contentSHA256())
 	t.req.Header.SetContentEncoding(contentEncoding)
 	t.req.Header.Set(HeaderXAmzDecodedContentLength, strconv.Itoa(len(t.req.Body())))
@@ -73,23 +225,27 @@ func (t *streamTransformer) Prepare() error {
 	return nil
 }
 
-func (t *streamTransformer) Transform(seed, date, scope string, signingKey []byte) {
-	writeChunk := func(chunk []byte, previousSignature string) string {
+func (t *streamTransformer) Transform(seed, date string, algorithm signingAlgorithm) error {
+	writeChunk := func(chunk []byte, previousSignature string) (string, error) {
 		stringToSign := fmt.Sprintf(
-			"AWS4-HMAC-SHA256-PAYLOAD\n%s\n%s\n%s\n%s\n%s",
+			"%s-PAYLOAD\n%s\n%s\n%s\n%s\n%s",
+			algorithm.Name(),
 			date,
-			scope,
+			algorithm.Scope(),
 			previousSignature,
 			emptyHash,
 			utils.Hex(utils.SHA256Hash(chunk)),
 		)
 
-		currentSignature := utils.Hex(utils.HMAC_SHA256(signingKey, []byte(stringToSign)))
+		currentSignature, err := algorithm.Sign(stringToSign)
+		if err != nil {
+			return "", err
+		}
 
 		t.buf.WriteString(strconv.FormatInt(int64(len(chunk)), 16))
 		if t.signBody {
 			t.buf.WriteString(";chunk-signature=")
-			t.buf.WriteString(currentSignature)
+			t.buf.WriteString(algorithm.ChunkSignature(currentSignature))
 		}
 		t.buf.WriteString("\r\n")
 		if len(chunk) > 0 {
@@ -97,32 +253,52 @@ func (t *streamTransformer) Transform(seed, date, scope string, signingKey []byt
 			t.buf.WriteString("\r\n")
 		}
 
-		return currentSignature
+		return currentSignature, nil
+	}
+
+	var err error
+	for chunk := range slices.Chunk(t.req.Body(), t.chunkSize) {
+		if seed, err = writeChunk(chunk, seed); err != nil {
+			return err
+		}
 	}
 
-	for chunk := range slices.Chunk(t.req.Body(), chunkDataSize) {
-		seed = writeChunk(chunk, seed)
+	if seed, err = writeChunk(nil, seed); err != nil {
+		return err
 	}
 
-	seed = writeChunk(nil, seed)
+	if len(t.trailers) > 0 {
+		trailerHash := sha256.New()
+		for _, trailer := range t.trailers {
+			trailerHash.Write(trailer.name)
+			trailerHash.Write(trailerKeyValueSeparator)
+			trailerHash.Write(trailer.value)
+			trailerHash.Write([]byte{'\n'})
+		}
 
-	if len(t.trailerName) > 0 {
 		stringToSign := fmt.Sprintf(
-			"AWS4-HMAC-SHA256-TRAILER\n%s\n%s\n%s\n%s",
+			"%s-TRAILER\n%s\n%s\n%s\n%s",
+			algorithm.Name(),
 			date,
-			scope,
+			algorithm.Scope(),
 			seed,
-			utils.Hex(utils.SHA256Hash(slices.Concat(t.trailerName, trailerKeyValueSeparator, t.trailerValue, []byte{'\n'}))),
+			utils.Hex(trailerHash.Sum(nil)),
 		)
 
-		currentSignature := utils.Hex(utils.HMAC_SHA256(signingKey, []byte(stringToSign)))
-		t.buf.Write(t.trailerName)
-		t.buf.Write(trailerKeyValueSeparator)
-		t.buf.Write(t.trailerValue)
-		t.buf.WriteString("\r\n")
+		currentSignature, err := algorithm.Sign(stringToSign)
+		if err != nil {
+			return err
+		}
+
+		for _, trailer := range t.trailers {
+			t.buf.Write(trailer.name)
+			t.buf.Write(trailerKeyValueSeparator)
+			t.buf.Write(trailer.value)
+			t.buf.WriteString("\r\n")
+		}
 		if t.signBody {
 			t.buf.WriteString("x-amz-trailer-signature:")
-			t.buf.WriteString(currentSignature)
+			t.buf.WriteString(algorithm.ChunkSignature(currentSignature))
 			t.buf.WriteString("\r\n")
 		}
 		t.buf.WriteString("\r\n")
@@ -130,6 +306,8 @@ func (t *streamTransformer) Transform(seed, date, scope string, signingKey []byt
 
 	t.req.Header.SetContentLength(t.buf.Len())
 	t.req.SetBody(t.buf.Bytes())
+
+	return nil
 }
 
 func (t *streamTransformer) contentSHA256() string {
@@ -137,21 +315,21 @@ func (t *streamTransformer) contentSHA256() string {
 		return "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
 	}
 
-	if len(t.trailerName) == 0 {
-		return "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	if len(t.trailers) == 0 {
+		return "STREAMING-" + t.algorithmName + "-PAYLOAD"
 	}
 
-	return "STREAMING-AWS4-HMAC-SHA256-PAYLOAD-TRAILER"
+	return "STREAMING-" + t.algorithmName + "-PAYLOAD-TRAILER"
 }
 
 func (t *streamTransformer) transformedBodyLen() int {
-	const signatureSize = len(";chunk-signature=") + signatureValueLen
+	signatureSize := len(";chunk-signature=") + t.signatureLen
 
 	decodedContentLength := len(t.req.Body())
 
 	bodyLen := decodedContentLength
-	if nbChunk := decodedContentLength / chunkDataSize; nbChunk > 0 {
-		chunkSize := len(strconv.FormatInt(chunkDataSize, 16)) + len(crlf)
+	if nbChunk := decodedContentLength / t.chunkSize; nbChunk > 0 {
+		chunkSize := len(strconv.FormatInt(int64(t.chunkSize), 16)) + len(crlf)
 		if t.signBody {
 			chunkSize += signatureSize
 		}
@@ -160,7 +338,7 @@ func (t *streamTransformer) transformedBodyLen() int {
 		bodyLen += nbChunk * chunkSize
 	}
 
-	if remaining := decodedContentLength % chunkDataSize; remaining > 0 {
+	if remaining := decodedContentLength % t.chunkSize; remaining > 0 {
 		bodyLen += len(strconv.FormatInt(int64(remaining), 16)) + len(crlf)
 		if t.signBody {
 			bodyLen += signatureSize
@@ -173,11 +351,13 @@ func (t *streamTransformer) transformedBodyLen() int {
 		bodyLen += signatureSize
 	}
 
-	if t.trailerName != nil {
-		bodyLen += len(t.trailerName) + len(trailerKeyValueSeparator) + len(t.trailerValue) + len(crlf)
+	if len(t.trailers) > 0 {
+		for _, trailer := range t.trailers {
+			bodyLen += trailer.lineLen() + len(crlf)
+		}
 
 		if t.signBody {
-			bodyLen += len("x-amz-trailer-signature:") + signatureValueLen + len(crlf)
+			bodyLen += len("x-amz-trailer-signature:") + t.signatureLen + len(crlf)
 		}
 
 		bodyLen += len(crlf)
@@ -186,15 +366,41 @@ func (t *streamTransformer) transformedBodyLen() int {
 	return bodyLen
 }
 
-type HeaderSigner struct {
+// payloadOptions configures how the payload is signed and sent.
+type payloadOptions struct {
 	signBody       bool
 	forceStreaming bool
+	chunkSize      int
 }
 
-func NewHeaderSigner(signBody, forceStreaming bool) *HeaderSigner {
-	return &HeaderSigner{
+func newPayloadOptions(signBody, forceStreaming bool) payloadOptions {
+	return payloadOptions{
 		signBody:       signBody,
 		forceStreaming: forceStreaming,
+		chunkSize:      DefaultChunkSize,
+	}
+}
+
+func (o *payloadOptions) setChunkSize(size int) error {
+	if size < MinChunkSize {
+		return fmt.Errorf("chunk size must be at least %d bytes, got %d", MinChunkSize, size)
+	}
+
+	o.chunkSize = size
+	return nil
+}
+
+type HeaderSigner struct {
+	payload payloadOptions
+	service string
+
+	keys signingKeyCache
+}
+
+func NewHeaderSigner(signBody, forceStreaming bool) *HeaderSigner {
+	return &HeaderSigner{
+		payload: newPayloadOptions(signBody, forceStreaming),
+		service: DefaultService,
 	}
 }
 
@@ -202,202 +408,352 @@ func (s *HeaderSigner) Sign(req *fasthttp.Request, credentials *signer.Credentia
 	// AWS S3 specify the use of UTC time
 	now = now.UTC()
 
-	ctx := &headerSigningCtx{
-		Signer: *s,
+	return signRequest(req, credentials, now, &s.payload, s.keys.get(credentials, region, s.service, now))
+}
 
-		Req:         req,
-		Credentials: *credentials,
-		Region:      region,
-		Now:         now.UTC(),
-		haveTrailer: len(req.Header.Peek(HeaderXAmzTrailer)) > 0,
+// SetChunkSize changes the size of the aws-chunked chunks, [DefaultChunkSize] by default.
+// Larger chunks reduce the signing overhead of large payloads, the minimum is [MinChunkSize].
+func (s *HeaderSigner) SetChunkSize(size int) error {
+	return s.payload.setChunkSize(size)
+}
 
-		scope: now.Format(FormatYYYYMMDD) + "/" + region + "/s3/aws4_request",
-	}
+// SetService changes the signed service, [DefaultService] by default, to sign the requests
+// of other AWS services such as STS.
+func (s *HeaderSigner) SetService(service string) {
+	s.service = service
+}
 
-	impl, err := s.getPayloadTransformer(req)
+func signRequest(
+	req *fasthttp.Request,
+	credentials *signer.Credentials,
+	now time.Time,
+	payload *payloadOptions,
+	algorithm signingAlgorithm,
+) (canonicalRequest string, stringToSign string, err error) {
+	impl, err := getPayloadTransformer(req, payload, algorithm)
 	if err != nil {
 		return "", "", err
 	}
 
+	var dateBuf [len(FormatXAmzDate)]byte
+	date := now.AppendFormat(dateBuf[:0], FormatXAmzDate)
+
 	// Sanitize request
 	req.Header.Del(HeaderAuthorization)
 	req.Header.Del(HeaderXAmzContentSHA256)
-	req.Header.Set(HeaderXAmzDate, now.Format(FormatXAmzDate))
+	req.Header.SetBytesV(HeaderXAmzDate, date)
+
+	if credentials.SessionToken != "" {
+		req.Header.Set(HeaderXAmzSecurityToken, credentials.SessionToken)
+	}
 
 	if err := impl.Prepare(); err != nil {
 		return "", "", err
 	}
 
-	ctx.computeHeaders()
+	ctx := acquireHeaderSigningCtx()
+	defer releaseHeaderSigningCtx(ctx)
 
-	ctx.computeSigningKey()
+	ctx.writeCanonicalRequest(req)
+	canonicalRequest = ctx.canonicalRequest.String()
 
-	ctx.computeCanonicalRequest()
-	ctx.computeStringToSign()
+	ctx.writeStringToSign(algorithm, date)
+	stringToSign = ctx.buf.String()
 
-	signature := ctx.getSignature()
+	signature, err := algorithm.Sign(stringToSign)
+	if err != nil {
+		return "", "", err
+	}
 
-	req.Header.Set(
-		HeaderAuthorization,
-		fmt.Sprintf(
-			"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
-			credentials.AccessKeyID,
-			ctx.scope,
-			ctx.signedHeaders,
-			signature,
-		),
-	)
+	ctx.writeAuthorization(algorithm, credentials, signature)
+	req.Header.SetBytesV(HeaderAuthorization, ctx.buf.Bytes())
 
-	impl.Transform(
-		signature,
-		ctx.Now.Format(FormatXAmzDate),
-		ctx.scope,
-		ctx.signingKey,
-	)
+	if err := impl.Transform(signature, string(date), algorithm); err != nil {
+		return "", "", err
+	}
 
-	return ctx.canonicalRequest, ctx.stringToSign, nil
+	return canonicalRequest, stringToSign, nil
 }
 
-func (s *HeaderSigner) getPayloadTransformer(req *fasthttp.Request) (payloadTransformer, error) {
-	trailerName := req.Header.Peek(HeaderXAmzTrailer)
-	var trailerValue []byte
-
-	haveTrailer := len(trailerName) > 0
-
-	if haveTrailer {
-		trailerValue = req.Header.PeekBytes(trailerName)
-		if len(trailerValue) == 0 {
-			return nil, fmt.Errorf("no value set for trailer: %q", trailerName)
-		}
+func getPayloadTransformer(req *fasthttp.Request, payload *payloadOptions, algorithm signingAlgorithm) (payloadTransformer, error) {
+	trailers, err := getTrailers(req)
+	if err != nil {
+		return nil, err
 	}
 
-	if !s.signBody && s.forceStreaming && !haveTrailer {
+	haveTrailer := len(trailers) > 0
+
+	if !payload.signBody && payload.forceStreaming && !haveTrailer {
 		return nil, errors.New("cannot stream an unsigned payload without trailer")
 	}
 
-	if !s.forceStreaming && !haveTrailer {
+	if !payload.forceStreaming && !haveTrailer {
 		return &plainTransformer{
 				req:      req,
-				signBody: s.signBody,
+				signBody: payload.signBody,
 			},
 			nil
 	}
 
 	return &streamTransformer{
-			req:          req,
-			signBody:     s.signBody,
-			trailerName:  trailerName,
-			trailerValue: trailerValue,
+			req:           req,
+			signBody:      payload.signBody,
+			trailers:      trailers,
+			chunkSize:     payload.chunkSize,
+			algorithmName: algorithm.Name(),
+			signatureLen:  algorithm.ChunkSignatureLen(),
 		},
 		nil
 }
 
-type headerSigningCtx struct {
-	Signer HeaderSigner
+// getTrailers returns the trailers listed by the X-Amz-Trailer header.
+// The value of a trailer is taken from the header of the same name,
+// or computed from the payload for the checksum trailers.
+func getTrailers(req *fasthttp.Request) ([]trailer, error) {
+	var ret []trailer
+
+	for name := range bytes.SplitSeq(req.Header.Peek(HeaderXAmzTrailer), []byte{','}) {
+		name = bytes.TrimSpace(name)
+		if len(name) == 0 {
+			continue
+		}
 
-	Req         *fasthttp.Request
-	Credentials signer.Credentials
-	Region      string
-	Now         time.Time
+		value := req.Header.PeekBytes(name)
+		if len(value) == 0 {
+			checksum, found := computeChecksum(name, req.Body())
+			if !found {
+				return nil, fmt.Errorf("no value set for trailer: %q", name)
+			}
 
-	haveTrailer bool
+			value = checksum
+		}
 
-	scope            string
-	canonicalHeaders string
-	signedHeaders    string
-	signingKey       []byte
+		ret = append(ret, trailer{
+			name:  bytes.Clone(name),
+			value: bytes.Clone(value),
+		})
+	}
 
-	canonicalRequest string
-	stringToSign     string
+	return ret, nil
 }
 
-func (ctx *headerSigningCtx) computeSigningKey() {
-	dateKey := utils.HMAC_SHA256([]byte("AWS4"+ctx.Credentials.SecretAccessKey), []byte(ctx.Now.Format(FormatYYYYMMDD)))
-	dateRegionKey := utils.HMAC_SHA256(dateKey, []byte(ctx.Region))
-	dateRegionServiceKey := utils.HMAC_SHA256(dateRegionKey, []byte("s3"))
-	ctx.signingKey = utils.HMAC_SHA256(dateRegionServiceKey, []byte("aws4_request"))
-}
+var headerSigningCtxPool sync.Pool
 
-func (ctx *headerSigningCtx) computeHeaders() {
-	normalized := make(map[string]string, ctx.Req.Header.Len())
+func acquireHeaderSigningCtx() *headerSigningCtx {
+	ctx, ok := headerSigningCtxPool.Get().(*headerSigningCtx)
+	if !ok {
+		return &headerSigningCtx{}
+	}
 
-	ctx.Req.Header.VisitAll(func(key, value []byte) {
-		normalizedKey := utils.LowerCase(string(key))
-		normalizedValue := utils.Trim(string(value))
+	return ctx
+}
 
-		normalized[normalizedKey] = normalizedValue
-	})
+func releaseHeaderSigningCtx(ctx *headerSigningCtx) {
+	ctx.canonicalRequest.Reset()
+	ctx.buf.Reset()
+	ctx.entries.reset()
 
-	// Ensure host header presence
-	if _, exists := normalized["host"]; !exists {
-		normalized["host"] = string(ctx.Req.Host())
-	}
+	headerSigningCtxPool.Put(ctx)
+}
 
-	sortedHeaders := slices.Sorted(maps.Keys(normalized))
-	for _, key := range sortedHeaders {
-		ctx.canonicalHeaders += key + ":" + normalized[key] + "\n"
-	}
+// headerSigningCtx holds the reusable buffers of a signature.
+type headerSigningCtx struct {
+	canonicalRequest bytes.Buffer
 
-	ctx.signedHeaders = strings.Join(sortedHeaders, ";")
-}
+	// signedHeadersStart and signedHeadersEnd locate the signed headers in the canonical request
+	signedHeadersStart int
+	signedHeadersEnd   int
 
-func (ctx *headerSigningCtx) getSignature() string {
-	return utils.Hex(utils.HMAC_SHA256(ctx.signingKey, []byte(ctx.stringToSign)))
-}
+	// buf holds the string to sign, then the Authorization header
+	buf bytes.Buffer
 
-func (ctx *headerSigningCtx) computeStringToSign() {
-	ctx.stringToSign = "AWS4-HMAC-SHA256\n" +
-		ctx.Now.Format(FormatXAmzDate) + "\n" +
-		ctx.scope + "\n" +
-		utils.Hex(utils.SHA256Hash([]byte(ctx.canonicalRequest)))
+	entries canonicalEntries
 }
 
-func (ctx *headerSigningCtx) computeCanonicalRequest() {
-	var ret strings.Builder
+func (ctx *headerSigningCtx) writeCanonicalRequest(req *fasthttp.Request) {
+	ret := &ctx.canonicalRequest
 
-	ret.Write(ctx.Req.Header.Method())
+	ret.Write(req.Header.Method())
 	ret.WriteByte('\n')
 
-	path := string(ctx.Req.URI().PathOriginal())
-	if path == "" {
-		path = "/"
+	path := req.URI().PathOriginal()
+	if len(path) == 0 {
+		path = []byte{'/'}
 	}
-	ret.WriteString(utils.URIEncode(path, true))
+	ret.Write(utils.AppendURIEncode(ret.AvailableBuffer(), path, true))
 	ret.WriteByte('\n')
 
-	ret.WriteString(ctx.getCanonicalQueryString())
+	ctx.writeCanonicalQueryString(req)
 	ret.WriteByte('\n')
 
-	ret.WriteString(ctx.canonicalHeaders)
+	ctx.writeCanonicalHeaders(req)
 	ret.WriteByte('\n')
 
-	ret.WriteString(ctx.signedHeaders)
-	ret.WriteByte('\n')
+	ctx.signedHeadersStart = ret.Len()
+	for i, entry := range ctx.entries.entries {
+		if i > 0 {
+			ret.WriteByte(';')
+		}
 
-	ret.Write(ctx.Req.Header.Peek(HeaderXAmzContentSHA256))
+		ret.Write(ctx.entries.key(entry))
+	}
+	ctx.signedHeadersEnd = ret.Len()
+	ret.WriteByte('\n')
 
-	ctx.canonicalRequest = ret.String()
+	ret.Write(req.Header.Peek(HeaderXAmzContentSHA256))
 }
 
-func (ctx *headerSigningCtx) getCanonicalQueryString() string {
-	args := ctx.Req.URI().QueryArgs()
-	encoded := make(map[string]string, args.Len())
+func (ctx *headerSigningCtx) writeCanonicalQueryString(req *fasthttp.Request) {
+	ctx.entries.reset()
 
-	args.VisitAll(func(key, value []byte) {
-		encoded[utils.URIEncode(string(key), false)] = utils.URIEncode(string(value), false)
+	req.URI().QueryArgs().VisitAll(func(key, value []byte) {
+		start := len(ctx.entries.arena)
+		ctx.entries.arena = utils.AppendURIEncode(ctx.entries.arena, key, false)
+		keyEnd := len(ctx.entries.arena)
+		ctx.entries.arena = utils.AppendURIEncode(ctx.entries.arena, value, false)
+		ctx.entries.add(start, keyEnd)
 	})
 
-	var ret strings.Builder
+	ctx.entries.sortUnique()
 
-	for i, key := range slices.Sorted(maps.Keys(encoded)) {
+	for i, entry := range ctx.entries.entries {
 		if i > 0 {
-			ret.WriteRune('&')
+			ctx.canonicalRequest.WriteByte('&')
+		}
+
+		ctx.canonicalRequest.Write(ctx.entries.key(entry))
+		ctx.canonicalRequest.WriteByte('=')
+		ctx.canonicalRequest.Write(ctx.entries.value(entry))
+	}
+}
+
+// writeCanonicalHeaders writes the canonical headers and keeps them in the entries for the signed headers.
+func (ctx *headerSigningCtx) writeCanonicalHeaders(req *fasthttp.Request) {
+	ctx.entries.reset()
+
+	haveHost := false
+	add := func(key, value []byte) {
+		start := len(ctx.entries.arena)
+		ctx.entries.arena = utils.AppendLowerCase(ctx.entries.arena, key)
+		keyEnd := len(ctx.entries.arena)
+		ctx.entries.arena = appendCanonicalHeaderValue(ctx.entries.arena, value)
+		ctx.entries.add(start, keyEnd)
+
+		haveHost = haveHost || string(ctx.entries.arena[start:keyEnd]) == "host"
+	}
+
+	req.Header.VisitAll(add)
+
+	// Ensure host header presence
+	if !haveHost {
+		add([]byte("host"), req.Host())
+	}
+
+	ctx.entries.sortUnique()
+
+	for _, entry := range ctx.entries.entries {
+		ctx.canonicalRequest.Write(ctx.entries.key(entry))
+		ctx.canonicalRequest.WriteByte(':')
+		ctx.canonicalRequest.Write(ctx.entries.value(entry))
+		ctx.canonicalRequest.WriteByte('\n')
+	}
+}
+
+// appendCanonicalHeaderValue appends the value without the leading and trailing spaces,
+// and with the sequential spaces converted to a single space.
+func appendCanonicalHeaderValue(dst, value []byte) []byte {
+	previousSpace := false
+	for _, c := range bytes.TrimSpace(value) {
+		if c == ' ' && previousSpace {
+			continue
+		}
+
+		previousSpace = c == ' '
+		dst = append(dst, c)
+	}
+
+	return dst
+}
+
+func (ctx *headerSigningCtx) writeStringToSign(algorithm signingAlgorithm, date []byte) {
+	ctx.buf.Reset()
+
+	ctx.buf.WriteString(algorithm.Name())
+	ctx.buf.WriteByte('\n')
+	ctx.buf.Write(date)
+	ctx.buf.WriteByte('\n')
+	ctx.buf.WriteString(algorithm.Scope())
+	ctx.buf.WriteByte('\n')
+
+	hash := sha256.Sum256(ctx.canonicalRequest.Bytes())
+	ctx.buf.Write(hex.AppendEncode(ctx.buf.AvailableBuffer(), hash[:]))
+}
+
+func (ctx *headerSigningCtx) writeAuthorization(algorithm signingAlgorithm, credentials *signer.Credentials, signature string) {
+	ctx.buf.Reset()
+
+	ctx.buf.WriteString(algorithm.Name())
+	ctx.buf.WriteString(" Credential=")
+	ctx.buf.WriteString(credentials.AccessKeyID)
+	ctx.buf.WriteByte('/')
+	ctx.buf.WriteString(algorithm.Scope())
+	ctx.buf.WriteString(", SignedHeaders=")
+	ctx.buf.Write(ctx.canonicalRequest.Bytes()[ctx.signedHeadersStart:ctx.signedHeadersEnd])
+	ctx.buf.WriteString(", Signature=")
+	ctx.buf.WriteString(signature)
+}
+
+// canonicalEntries stores key-value pairs in a single buffer to avoid an allocation per pair.
+type canonicalEntries struct {
+	arena   []byte
+	entries []canonicalEntry
+}
+
+// canonicalEntry is the key arena[keyStart:keyEnd] and the value arena[keyEnd:valueEnd].
+type canonicalEntry struct {
+	keyStart int
+	keyEnd   int
+	valueEnd int
+}
+
+func (e *canonicalEntries) reset() {
+	e.arena = e.arena[:0]
+	e.entries = e.entries[:0]
+}
+
+// add registers the pair appended to the arena since start.
+func (e *canonicalEntries) add(start, keyEnd int) {
+	e.entries = append(e.entries, canonicalEntry{
+		keyStart: start,
+		keyEnd:   keyEnd,
+		valueEnd: len(e.arena),
+	})
+}
+
+func (e *canonicalEntries) key(entry canonicalEntry) []byte {
+	return e.arena[entry.keyStart:entry.keyEnd]
+}
+
+func (e *canonicalEntries) value(entry canonicalEntry) []byte {
+	return e.arena[entry.keyEnd:entry.valueEnd]
+}
+
+// sortUnique sorts the entries by key, keeping the last value of duplicated keys.
+func (e *canonicalEntries) sortUnique() {
+	slices.SortStableFunc(e.entries, func(a, b canonicalEntry) int {
+		return bytes.Compare(e.key(a), e.key(b))
+	})
+
+	n := 0
+	for _, entry := range e.entries {
+		if n > 0 && bytes.Equal(e.key(e.entries[n-1]), e.key(entry)) {
+			e.entries[n-1] = entry
+			continue
 		}
 
-		ret.WriteString(key)
-		ret.WriteRune('=')
-		ret.WriteString(encoded[key])
+		e.entries[n] = entry
+		n++
 	}
 
-	return ret.String()
+	e.entries = e.entries[:n]
 }
@@ -0,0 +1,203 @@
+package v4
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/s3hobby/client/pkg/signer"
+	"github.com/s3hobby/client/pkg/signer/utils"
+
+	"github.com/valyala/fasthttp"
+)
+
+const v4aAlgorithm = "AWS4-ECDSA-P256-SHA256"
+
+// v4aChunkSignatureLen is the hexadecimal length of the largest P-256 ASN.1 signature.
+// Shorter chunk signatures are padded with v4aSignaturePadding.
+const v4aChunkSignatureLen = 144
+const v4aSignaturePadding = "*"
+
+var p256NMinusTwo = new(big.Int).Sub(elliptic.P256().Params().N, big.NewInt(2))
+
+var _ signer.Signer = (*V4AHeaderSigner)(nil)
+
+// V4AHeaderSigner implements the asymmetric Signature Version 4 (SigV4a),
+// required by multi-region access points.
+// The signature is valid for all the regions of the region set.
+type V4AHeaderSigner struct {
+	payload   payloadOptions
+	regionSet []string
+	service   string
+
+	keyMu          sync.Mutex
+	keyCredentials signer.Credentials
+	key            *ecdsa.PrivateKey
+}
+
+// NewV4AHeaderSigner returns a SigV4a signer.
+// The region given to Sign is used when regionSet is empty,
+// use "*" to sign for all the regions.
+func NewV4AHeaderSigner(signBody, forceStreaming bool, regionSet ...string) *V4AHeaderSigner {
+	return &V4AHeaderSigner{
+		payload:   newPayloadOptions(signBody, forceStreaming),
+		regionSet: regionSet,
+		service:   DefaultService,
+	}
+}
+
+func (s *V4AHeaderSigner) Sign(req *fasthttp.Request, credentials *signer.Credentials, region string, now time.Time) (canonicalRequest string, stringToSign string, err error) {
+	// AWS S3 specify the use of UTC time
+	now = now.UTC()
+
+	regionSet := region
+	if len(s.regionSet) > 0 {
+		regionSet = strings.Join(s.regionSet, ",")
+	}
+
+	if regionSet == "" {
+		return "", "", errors.New("SigV4a: empty region set")
+	}
+
+	key, err := s.privateKey(credentials)
+	if err != nil {
+		return "", "", err
+	}
+
+	req.Header.Set(HeaderXAmzRegionSet, regionSet)
+
+	return signRequest(req, credentials, now, &s.payload, &ecdsaAlgorithm{
+		scope: now.Format(FormatYYYYMMDD) + "/" + s.service + "/aws4_request",
+		key:   key,
+	})
+}
+
+// SetChunkSize changes the size of the aws-chunked chunks, see [HeaderSigner.SetChunkSize].
+func (s *V4AHeaderSigner) SetChunkSize(size int) error {
+	return s.payload.setChunkSize(size)
+}
+
+// SetService changes the signed service, [DefaultService] by default, see [HeaderSigner.SetService].
+func (s *V4AHeaderSigner) SetService(service string) {
+	s.service = service
+}
+
+// privateKey caches the key of the last credentials since its derivation is expensive.
+func (s *V4AHeaderSigner) privateKey(credentials *signer.Credentials) (*ecdsa.PrivateKey, error) {
+	s.keyMu.Lock()
+	defer s.keyMu.Unlock()
+
+	if s.key != nil && s.keyCredentials == *credentials {
+		return s.key, nil
+	}
+
+	key, err := DeriveV4APrivateKey(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	s.key = key
+	s.keyCredentials = *credentials
+
+	return key, nil
+}
+
+// DeriveV4APrivateKey derives the SigV4a ECDSA P-256 key from the credentials,
+// using the NIST SP 800-108 KDF in counter mode with HMAC-SHA256.
+func DeriveV4APrivateKey(credentials *signer.Credentials) (*ecdsa.PrivateKey, error) {
+	const bitLen = 256
+	const maxCounter = 0xFF
+
+	inputKey := []byte("AWS4A" + credentials.SecretAccessKey)
+	kdfContext := make([]byte, len(credentials.AccessKeyID)+1)
+	copy(kdfContext, credentials.AccessKeyID)
+
+	for counter := 1; counter <= maxCounter; counter++ {
+		kdfContext[len(kdfContext)-1] = byte(counter)
+
+		candidate := new(big.Int).SetBytes(hmacKeyDerivation(inputKey, []byte(v4aAlgorithm), kdfContext, bitLen))
+		if candidate.Cmp(p256NMinusTwo) >= 0 {
+			continue
+		}
+
+		d := candidate.Add(candidate, big.NewInt(1))
+
+		var scalar [bitLen / 8]byte
+		d.FillBytes(scalar[:])
+
+		ecdhKey, err := ecdh.P256().NewPrivateKey(scalar[:])
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey := ecdhKey.PublicKey().Bytes() // 0x04 || X || Y
+
+		ret := new(ecdsa.PrivateKey)
+		ret.Curve = elliptic.P256()
+		ret.X = new(big.Int).SetBytes(publicKey[1 : 1+bitLen/8])
+		ret.Y = new(big.Int).SetBytes(publicKey[1+bitLen/8:])
+		ret.D = d
+
+		return ret, nil
+	}
+
+	return nil, errors.New("SigV4a: cannot derive a private key from the credentials")
+}
+
+// hmacKeyDerivation implements the NIST SP 800-108 KDF in counter mode for bitLen <= 256.
+func hmacKeyDerivation(key, label, context []byte, bitLen uint32) []byte {
+	var buf [4]byte
+
+	mac := hmac.New(sha256.New, key)
+
+	binary.BigEndian.PutUint32(buf[:], 1)
+	mac.Write(buf[:])
+	mac.Write(label)
+	mac.Write([]byte{0})
+	mac.Write(context)
+	binary.BigEndian.PutUint32(buf[:], bitLen)
+	mac.Write(buf[:])
+
+	return mac.Sum(nil)[:bitLen/8]
+}
+
+var _ signingAlgorithm = (*ecdsaAlgorithm)(nil)
+
+type ecdsaAlgorithm struct {
+	scope string
+	key   *ecdsa.PrivateKey
+}
+
+func (*ecdsaAlgorithm) Name() string {
+	return v4aAlgorithm
+}
+
+func (a *ecdsaAlgorithm) Scope() string {
+	return a.scope
+}
+
+func (a *ecdsaAlgorithm) Sign(stringToSign string) (string, error) {
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, utils.SHA256Hash([]byte(stringToSign)))
+	if err != nil {
+		return "", err
+	}
+
+	return utils.Hex(signature), nil
+}
+
+func (*ecdsaAlgorithm) ChunkSignature(signature string) string {
+	return signature + strings.Repeat(v4aSignaturePadding, v4aChunkSignatureLen-len(signature))
+}
+
+func (*ecdsaAlgorithm) ChunkSignatureLen() int {
+	return v4aChunkSignatureLen
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v4 "github.com/s3hobby/client/pkg/signer/v4"
+
+	"github.com/valyala/fasthttp"
+)
+
+// PresignedPost is the target and the form fields of a browser-based upload using HTTP POST.
+type PresignedPost struct {
+	URL    string
+	Fields map[string]string
+}
+
+// PresignPostObject signs the POST policy and resolves the bucket URL
+// the browser has to send the multipart/form-data body to.
+func (c *Client) PresignPostObject(ctx context.Context, policy *v4.PostPolicy, optFns ...func(*Options)) (*PresignedPost, error) {
+	options := c.options.With(optFns...)
+
+	credentials, err := options.retrieveCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials == nil {
+		return nil, errors.New("cannot presign POST policy: credentials are mandatory")
+	}
+
+	endpoint, err := options.EndpointResolver.ResolveEndpoint(ctx, EndpointParameters{
+		Bucket:       policy.Bucket(),
+		Host:         options.EndpointHost,
+		UseSSL:       options.UseSSL,
+		UsePathStyle: options.UsePathStyle,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve endpoint: %v", err)
+	}
+
+	var uri fasthttp.URI
+	if err = uri.Parse(nil, []byte(endpoint.URL)); err != nil {
+		return nil, fmt.Errorf("cannot parse endpoint: %v", err)
+	}
+
+	fields, err := policy.Sign(credentials, options.SiginingRegion, c.clockOffsets.Now(string(uri.Host())))
+	if err != nil {
+		return nil, fmt.Errorf("cannot presign POST policy: %v", err)
+	}
+
+	return &PresignedPost{
+		URL:    endpoint.URL,
+		Fields: fields,
+	}, nil
+}
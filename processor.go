@@ -32,10 +32,27 @@ type RequiredBucketKeyInterface interface {
 	GetKey() string
 }
 
+// RequiredPreflightInterface is implemented by the inputs describing a CORS preflight request.
+type RequiredPreflightInterface interface {
+	GetOrigin() string
+	GetAccessControlRequestMethod() string
+}
+
+// RequiredPolicyInterface is implemented by the inputs sending a policy document.
+type RequiredPolicyInterface interface {
+	GetPolicy() []byte
+}
+
+// RequiredIDInterface is implemented by the inputs addressing a bucket configuration by its id.
+type RequiredIDInterface interface {
+	GetID() string
+}
+
 type handlerInput[Input any] struct {
 	Options       *Options
 	CallInput     Input
 	ServerRequest fasthttp.Request
+	ClockOffsets  *clockOffsets
 }
 
 type handlerOutput[Output any] struct {
@@ -124,13 +141,55 @@ func (*resolveEndpointMiddleware[Input, Output]) Middleware(ctx context.Context,
 type signerMiddleware[Input any, Output any] struct{}
 
 func (*signerMiddleware[Input, Output]) Middleware(ctx context.Context, input *handlerInput[Input], next Handler[Input, Output]) (*handlerOutput[Output], error) {
-	if _, _, err := input.Options.Signer.Sign(&input.ServerRequest, input.Options.Credentials, input.Options.SiginingRegion, time.Now()); err != nil {
+	now := input.ClockOffsets.Now(string(input.ServerRequest.URI().Host()))
+
+	credentials, err := input.Options.retrieveCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err = input.Options.Signer.Sign(&input.ServerRequest, credentials, input.Options.SiginingRegion, now); err != nil {
 		return nil, fmt.Errorf("cannot sign the request: %v", err)
 	}
 
 	return next.Handle(ctx, input)
 }
 
+type clockSkewMiddleware[Input any, Output any] struct{}
+
+// Middleware learns the server clock from the responses, and sends once again,
+// with the corrected clock, a request rejected with RequestTimeTooSkewed.
+func (*clockSkewMiddleware[Input, Output]) Middleware(ctx context.Context, input *handlerInput[Input], next Handler[Input, Output]) (*handlerOutput[Output], error) {
+	host := string(input.ServerRequest.URI().Host())
+
+	// The request is marshaled and signed again on retry
+	var pristine fasthttp.Request
+	input.ServerRequest.CopyTo(&pristine)
+
+	output, err := next.Handle(ctx, input)
+	now := time.Now()
+
+	// learned is only true when the clock correction has changed,
+	// sending the request again with the same clock would be rejected again.
+	learned := false
+	if output != nil && output.ServerResponse != nil {
+		learned = input.ClockOffsets.observe(host, output.ServerResponse, now)
+	}
+
+	var serverSideError *ServerSideError
+	if !errors.As(err, &serverSideError) || !errors.Is(serverSideError, ErrRequestTimeTooSkewed) {
+		return output, err
+	}
+
+	if !input.ClockOffsets.observeSkewError(host, serverSideError, now) && !learned {
+		return output, err
+	}
+
+	pristine.CopyTo(&input.ServerRequest)
+
+	return next.Handle(ctx, input)
+}
+
 type transportMiddleware[
 	Input HTTPRequestMarshaler,
 	OutputBase any,
@@ -166,19 +225,54 @@ func (*transportMiddleware[Input, OutputBase, OutputPtr]) Middleware(ctx context
 type requiredInputMiddleware[Input any, Output any] struct{}
 
 func (*requiredInputMiddleware[Input, Output]) Middleware(ctx context.Context, input *handlerInput[Input], next Handler[Input, Output]) (*handlerOutput[Output], error) {
-	if v, ok := interface{}(input.CallInput).(RequiredBucketKeyInterface); ok {
+	if err := validateRequiredInput(input.CallInput); err != nil {
+		return nil, err
+	}
+
+	return next.Handle(ctx, input)
+}
+
+// validateRequiredInput checks the mandatory fields exposed by the Required*Interface of the input.
+func validateRequiredInput(callInput any) error {
+	if err := validateRequiredBucketKey(callInput); err != nil {
+		return err
+	}
+
+	if v, ok := callInput.(RequiredIDInterface); ok && v.GetID() == "" {
+		return errors.New("id is mandatory")
+	}
+
+	if v, ok := callInput.(RequiredPolicyInterface); ok && len(v.GetPolicy()) == 0 {
+		return errors.New("policy is mandatory")
+	}
+
+	if v, ok := callInput.(RequiredPreflightInterface); ok {
+		if v.GetOrigin() == "" {
+			return errors.New("origin is mandatory")
+		}
+
+		if v.GetAccessControlRequestMethod() == "" {
+			return errors.New("access control request method is mandatory")
+		}
+	}
+
+	return nil
+}
+
+func validateRequiredBucketKey(callInput any) error {
+	if v, ok := callInput.(RequiredBucketKeyInterface); ok {
 		if v.GetBucket() == "" {
-			return nil, errors.New("bucket is mandatory")
+			return errors.New("bucket is mandatory")
 		}
 
 		if v.GetKey() == "" {
-			return nil, errors.New("object key is mandatory")
+			return errors.New("object key is mandatory")
 		}
-	} else if v, ok := interface{}(input.CallInput).(RequiredBucketInterface); ok {
+	} else if v, ok := callInput.(RequiredBucketInterface); ok {
 		if v.GetBucket() == "" {
-			return nil, errors.New("bucket is mandatory")
+			return errors.New("bucket is mandatory")
 		}
 	}
 
-	return next.Handle(ctx, input)
+	return nil
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 )
 
 type EndpointParameters struct {
@@ -51,3 +52,66 @@ func (*defaultEndpointResolver) ResolveEndpoint(ctx context.Context, params Endp
 
 	return &Endpoint{URL: url}, nil
 }
+
+// DefaultWebsiteDomain is the domain of the AWS S3 website endpoints.
+const DefaultWebsiteDomain = "amazonaws.com"
+
+// websiteDashRegions use the legacy s3-website-<region> endpoint format.
+var websiteDashRegions = map[string]struct{}{
+	"us-east-1":      {},
+	"us-west-1":      {},
+	"us-west-2":      {},
+	"ap-southeast-1": {},
+	"ap-southeast-2": {},
+	"ap-northeast-1": {},
+	"eu-west-1":      {},
+	"sa-east-1":      {},
+	"us-gov-west-1":  {},
+}
+
+var _ EndpointResolver = (*WebsiteEndpointResolver)(nil)
+
+// WebsiteEndpointResolver resolves the static website hosting endpoint of a bucket,
+// e.g. http://bucket.s3-website.eu-west-3.amazonaws.com/key.
+// Website endpoints only support virtual-hosted style over HTTP,
+// [EndpointParameters.Host] and [EndpointParameters.UsePathStyle] are ignored.
+type WebsiteEndpointResolver struct {
+	// Region is mandatory
+	Region string
+
+	// Domain defaults to [DefaultWebsiteDomain].
+	Domain string
+}
+
+func (r *WebsiteEndpointResolver) ResolveEndpoint(ctx context.Context, params EndpointParameters) (*Endpoint, error) {
+	if r.Region == "" {
+		return nil, errors.New("website endpoint: region is mandatory")
+	}
+
+	if params.Bucket == "" {
+		return nil, errors.New("website endpoint: bucket is mandatory")
+	}
+
+	if params.UseSSL {
+		return nil, errors.New("website endpoint: HTTPS is not supported")
+	}
+
+	domain := r.Domain
+	if domain == "" {
+		domain = DefaultWebsiteDomain
+	}
+
+	separator := "."
+	if _, exists := websiteDashRegions[r.Region]; exists {
+		separator = "-"
+	}
+
+	url := "http://" + params.Bucket + ".s3-website" + separator + r.Region + "." + domain
+
+	if params.Key != "" {
+		url += "/"
+		url += params.Key
+	}
+
+	return &Endpoint{URL: url}, nil
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsiteEndpointResolver(t *testing.T) {
+	testCases := []struct {
+		name     string
+		resolver *WebsiteEndpointResolver
+		params   EndpointParameters
+		expected string
+	}{
+		{
+			name:     "dot region",
+			resolver: &WebsiteEndpointResolver{Region: "eu-west-3"},
+			params:   EndpointParameters{Bucket: "my-bucket"},
+			expected: "http://my-bucket.s3-website.eu-west-3.amazonaws.com",
+		},
+		{
+			name:     "dash region",
+			resolver: &WebsiteEndpointResolver{Region: "us-east-1"},
+			params:   EndpointParameters{Bucket: "my-bucket", Key: "index.html"},
+			expected: "http://my-bucket.s3-website-us-east-1.amazonaws.com/index.html",
+		},
+		{
+			name:     "custom domain",
+			resolver: &WebsiteEndpointResolver{Region: "cn-north-1", Domain: "amazonaws.com.cn"},
+			params:   EndpointParameters{Bucket: "my-bucket", Host: "ignored", UsePathStyle: true},
+			expected: "http://my-bucket.s3-website.cn-north-1.amazonaws.com.cn",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := tc.resolver.ResolveEndpoint(t.Context(), tc.params)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual.URL)
+		})
+	}
+
+	t.Run("https", func(t *testing.T) {
+		resolver := &WebsiteEndpointResolver{Region: "eu-west-3"}
+		_, err := resolver.ResolveEndpoint(t.Context(), EndpointParameters{Bucket: "my-bucket", UseSSL: true})
+		require.EqualError(t, err, "website endpoint: HTTPS is not supported")
+	})
+}
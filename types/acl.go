@@ -0,0 +1,81 @@
+package types
+
+import "encoding/xml"
+
+// XMLSchemaInstanceNamespace is the namespace of the xsi:type attribute of [Grantee].
+const XMLSchemaInstanceNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+type AccessControlPolicy struct {
+	Owner  *Owner
+	Grants []Grant `xml:"AccessControlList>Grant"`
+}
+
+type Grant struct {
+	Grantee    *Grantee
+	Permission *Permission
+}
+
+type Permission string
+
+const (
+	PermissionFullControl Permission = "FULL_CONTROL"
+	PermissionWrite       Permission = "WRITE"
+	PermissionWriteACP    Permission = "WRITE_ACP"
+	PermissionRead        Permission = "READ"
+	PermissionReadACP     Permission = "READ_ACP"
+)
+
+// Grantee is serialized with its Type as the xsi:type attribute.
+//   - [GranteeTypeCanonicalUser] requires ID
+//   - [GranteeTypeAmazonCustomerByEmail] requires EmailAddress
+//   - [GranteeTypeGroup] requires URI
+type Grantee struct {
+	Type GranteeType `xml:"-"`
+
+	ID           *string
+	DisplayName  *string
+	EmailAddress *string
+	URI          *string
+}
+
+type GranteeType string
+
+const (
+	GranteeTypeCanonicalUser         GranteeType = "CanonicalUser"
+	GranteeTypeAmazonCustomerByEmail GranteeType = "AmazonCustomerByEmail"
+	GranteeTypeGroup                 GranteeType = "Group"
+)
+
+// granteeFields has the same fields as [Grantee] without its XML methods.
+type granteeFields Grantee
+
+// MarshalXML writes the xsi prefix explicitly since encoding/xml would
+// otherwise generate its own prefix, which some servers do not understand.
+func (g Grantee) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(
+		start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: XMLSchemaInstanceNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:type"}, Value: string(g.Type)},
+	)
+
+	return e.EncodeElement(granteeFields(g), start)
+}
+
+// UnmarshalXML reads the xsi:type attribute, including when the xsi prefix
+// is not bound to [XMLSchemaInstanceNamespace] by the document.
+func (g *Grantee) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var fields granteeFields
+	if err := d.DecodeElement(&fields, &start); err != nil {
+		return err
+	}
+
+	*g = Grantee(fields)
+
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "type" && (attr.Name.Space == XMLSchemaInstanceNamespace || attr.Name.Space == "xsi") {
+			g.Type = GranteeType(attr.Value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessControlPolicy(t *testing.T) {
+	policy := AccessControlPolicy{
+		Owner: &Owner{
+			ID:          utils.ToPtr("owner-id"),
+			DisplayName: utils.ToPtr("owner-name"),
+		},
+		Grants: []Grant{
+			{
+				Grantee: &Grantee{
+					Type: GranteeTypeCanonicalUser,
+					ID:   utils.ToPtr("user-id"),
+				},
+				Permission: utils.ToPtr(PermissionFullControl),
+			},
+			{
+				Grantee: &Grantee{
+					Type: GranteeTypeGroup,
+					URI:  utils.ToPtr("http://acs.amazonaws.com/groups/global/AllUsers"),
+				},
+				Permission: utils.ToPtr(PermissionRead),
+			},
+			{
+				Grantee: &Grantee{
+					Type:         GranteeTypeAmazonCustomerByEmail,
+					EmailAddress: utils.ToPtr("user@example.com"),
+				},
+				Permission: utils.ToPtr(PermissionWriteACP),
+			},
+		},
+	}
+
+	expected := `<AccessControlPolicy>` +
+		`<Owner><DisplayName>owner-name</DisplayName><ID>owner-id</ID></Owner>` +
+		`<AccessControlList>` +
+		`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>user-id</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>` +
+		`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group"><URI>http://acs.amazonaws.com/groups/global/AllUsers</URI></Grantee><Permission>READ</Permission></Grant>` +
+		`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="AmazonCustomerByEmail"><EmailAddress>user@example.com</EmailAddress></Grantee><Permission>WRITE_ACP</Permission></Grant>` +
+		`</AccessControlList>` +
+		`</AccessControlPolicy>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&policy)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual AccessControlPolicy
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, policy, actual)
+	})
+
+	t.Run("unmarshal unbound prefix", func(t *testing.T) {
+		var actual Grantee
+		require.NoError(t, xml.Unmarshal([]byte(`<Grantee xsi:type="Group"><URI>uri</URI></Grantee>`), &actual))
+		require.Equal(t, Grantee{Type: GranteeTypeGroup, URI: utils.ToPtr("uri")}, actual)
+	})
+}
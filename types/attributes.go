@@ -0,0 +1,50 @@
+package types
+
+type ObjectAttribute string
+
+const (
+	ObjectAttributeETag         ObjectAttribute = "ETag"
+	ObjectAttributeChecksum     ObjectAttribute = "Checksum"
+	ObjectAttributeObjectParts  ObjectAttribute = "ObjectParts"
+	ObjectAttributeStorageClass ObjectAttribute = "StorageClass"
+	ObjectAttributeObjectSize   ObjectAttribute = "ObjectSize"
+)
+
+// GetObjectAttributesResponse only contains the requested [ObjectAttribute].
+type GetObjectAttributesResponse struct {
+	ETag         *string
+	Checksum     *Checksum
+	ObjectParts  *GetObjectAttributesParts
+	StorageClass *string
+	ObjectSize   *string
+}
+
+type Checksum struct {
+	ChecksumCRC32     *string
+	ChecksumCRC32C    *string
+	ChecksumCRC64NVME *string
+	ChecksumSHA1      *string
+	ChecksumSHA256    *string
+	ChecksumType      *string
+}
+
+type GetObjectAttributesParts struct {
+	// TotalPartsCount is the number of parts of the object,
+	// not the number of parts in this page.
+	TotalPartsCount      *string `xml:"PartsCount"`
+	PartNumberMarker     *string
+	NextPartNumberMarker *string
+	MaxParts             *string
+	IsTruncated          *string
+	Parts                []ObjectPart `xml:"Part"`
+}
+
+type ObjectPart struct {
+	PartNumber        *string
+	Size              *string
+	ChecksumCRC32     *string
+	ChecksumCRC32C    *string
+	ChecksumCRC64NVME *string
+	ChecksumSHA1      *string
+	ChecksumSHA256    *string
+}
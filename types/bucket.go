@@ -0,0 +1,32 @@
+package types
+
+type Tagging struct {
+	TagSet []Tag `xml:"TagSet>Tag"`
+}
+
+type OwnershipControls struct {
+	Rules []OwnershipControlsRule `xml:"Rule"`
+}
+
+type OwnershipControlsRule struct {
+	ObjectOwnership *ObjectOwnership
+}
+
+type ObjectOwnership string
+
+const (
+	ObjectOwnershipBucketOwnerPreferred ObjectOwnership = "BucketOwnerPreferred"
+	ObjectOwnershipObjectWriter         ObjectOwnership = "ObjectWriter"
+	ObjectOwnershipBucketOwnerEnforced  ObjectOwnership = "BucketOwnerEnforced"
+)
+
+type RequestPaymentConfiguration struct {
+	Payer *Payer
+}
+
+type Payer string
+
+const (
+	PayerRequester   Payer = "Requester"
+	PayerBucketOwner Payer = "BucketOwner"
+)
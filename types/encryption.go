@@ -0,0 +1,25 @@
+package types
+
+type ServerSideEncryptionConfiguration struct {
+	Rules []ServerSideEncryptionRule `xml:"Rule"`
+}
+
+type ServerSideEncryptionRule struct {
+	ApplyServerSideEncryptionByDefault *ServerSideEncryptionByDefault
+	BucketKeyEnabled                   *string
+}
+
+type ServerSideEncryptionByDefault struct {
+	SSEAlgorithm *ServerSideEncryption
+
+	// KMSMasterKeyID is only allowed with [ServerSideEncryptionAwsKms] and [ServerSideEncryptionAwsKmsDsse].
+	KMSMasterKeyID *string
+}
+
+type ServerSideEncryption string
+
+const (
+	ServerSideEncryptionAES256     ServerSideEncryption = "AES256"
+	ServerSideEncryptionAwsKms     ServerSideEncryption = "aws:kms"
+	ServerSideEncryptionAwsKmsDsse ServerSideEncryption = "aws:kms:dsse"
+)
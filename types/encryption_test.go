@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerSideEncryptionConfiguration(t *testing.T) {
+	configuration := ServerSideEncryptionConfiguration{
+		Rules: []ServerSideEncryptionRule{
+			{
+				ApplyServerSideEncryptionByDefault: &ServerSideEncryptionByDefault{
+					SSEAlgorithm:   utils.ToPtr(ServerSideEncryptionAwsKms),
+					KMSMasterKeyID: utils.ToPtr("arn:aws:kms:us-east-1:1234/5678example"),
+				},
+				BucketKeyEnabled: utils.ToPtr("true"),
+			},
+		},
+	}
+
+	expected := `<ServerSideEncryptionConfiguration><Rule>` +
+		`<ApplyServerSideEncryptionByDefault><SSEAlgorithm>aws:kms</SSEAlgorithm><KMSMasterKeyID>arn:aws:kms:us-east-1:1234/5678example</KMSMasterKeyID></ApplyServerSideEncryptionByDefault>` +
+		`<BucketKeyEnabled>true</BucketKeyEnabled>` +
+		`</Rule></ServerSideEncryptionConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual ServerSideEncryptionConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+}
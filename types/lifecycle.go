@@ -0,0 +1,83 @@
+package types
+
+type LifecycleConfiguration struct {
+	Rules []LifecycleRule `xml:"Rule"`
+}
+
+type LifecycleRule struct {
+	ID     *string
+	Filter *LifecycleRuleFilter
+	Status *ExpirationStatus
+
+	// Prefix is deprecated, use Filter instead.
+	Prefix *string
+
+	Expiration                     *LifecycleExpiration
+	Transitions                    []Transition                  `xml:"Transition"`
+	NoncurrentVersionTransitions   []NoncurrentVersionTransition `xml:"NoncurrentVersionTransition"`
+	NoncurrentVersionExpiration    *NoncurrentVersionExpiration
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload
+}
+
+// LifecycleRuleFilter must contain at most one of its fields.
+// Use And to combine several conditions.
+// An empty filter applies the rule to all the objects of the bucket.
+type LifecycleRuleFilter struct {
+	Prefix                *string
+	Tag                   *Tag
+	ObjectSizeGreaterThan *string
+	ObjectSizeLessThan    *string
+	And                   *LifecycleRuleAndOperator
+}
+
+type LifecycleRuleAndOperator struct {
+	Prefix                *string
+	Tags                  []Tag `xml:"Tag"`
+	ObjectSizeGreaterThan *string
+	ObjectSizeLessThan    *string
+}
+
+type ExpirationStatus string
+
+const (
+	ExpirationStatusEnabled  ExpirationStatus = "Enabled"
+	ExpirationStatusDisabled ExpirationStatus = "Disabled"
+)
+
+type LifecycleExpiration struct {
+	Date                      *string
+	Days                      *string
+	ExpiredObjectDeleteMarker *string
+}
+
+type Transition struct {
+	Date         *string
+	Days         *string
+	StorageClass *TransitionStorageClass
+}
+
+type NoncurrentVersionTransition struct {
+	NoncurrentDays          *string
+	NewerNoncurrentVersions *string
+	StorageClass            *TransitionStorageClass
+}
+
+type NoncurrentVersionExpiration struct {
+	NoncurrentDays          *string
+	NewerNoncurrentVersions *string
+}
+
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation *string
+}
+
+type TransitionStorageClass string
+
+const (
+	TransitionStorageClassGlacier            TransitionStorageClass = "GLACIER"
+	TransitionStorageClassStandardIA         TransitionStorageClass = "STANDARD_IA"
+	TransitionStorageClassOneZoneIA          TransitionStorageClass = "ONEZONE_IA"
+	TransitionStorageClassIntelligentTiering TransitionStorageClass = "INTELLIGENT_TIERING"
+	TransitionStorageClassDeepArchive        TransitionStorageClass = "DEEP_ARCHIVE"
+	TransitionStorageClassGlacierIR          TransitionStorageClass = "GLACIER_IR"
+)
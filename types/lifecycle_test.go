@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycleConfiguration(t *testing.T) {
+	configuration := LifecycleConfiguration{
+		Rules: []LifecycleRule{
+			{
+				ID:     utils.ToPtr("id1"),
+				Filter: &LifecycleRuleFilter{Prefix: utils.ToPtr("documents/")},
+				Status: utils.ToPtr(ExpirationStatusEnabled),
+				Transitions: []Transition{
+					{Days: utils.ToPtr("30"), StorageClass: utils.ToPtr(TransitionStorageClassGlacier)},
+				},
+			},
+			{
+				ID:         utils.ToPtr("id2"),
+				Filter:     &LifecycleRuleFilter{Prefix: utils.ToPtr("logs/")},
+				Status:     utils.ToPtr(ExpirationStatusEnabled),
+				Expiration: &LifecycleExpiration{Days: utils.ToPtr("365")},
+			},
+			{
+				ID: utils.ToPtr("id3"),
+				Filter: &LifecycleRuleFilter{
+					And: &LifecycleRuleAndOperator{
+						Prefix: utils.ToPtr("tax/"),
+						Tags: []Tag{
+							{Key: utils.ToPtr("key1"), Value: utils.ToPtr("value1")},
+							{Key: utils.ToPtr("key2"), Value: utils.ToPtr("value2")},
+						},
+						ObjectSizeGreaterThan: utils.ToPtr("500"),
+					},
+				},
+				Status: utils.ToPtr(ExpirationStatusDisabled),
+				NoncurrentVersionTransitions: []NoncurrentVersionTransition{
+					{NoncurrentDays: utils.ToPtr("30"), StorageClass: utils.ToPtr(TransitionStorageClassStandardIA)},
+				},
+				NoncurrentVersionExpiration: &NoncurrentVersionExpiration{
+					NoncurrentDays:          utils.ToPtr("90"),
+					NewerNoncurrentVersions: utils.ToPtr("2"),
+				},
+			},
+			{
+				ID:                             utils.ToPtr("id4"),
+				Filter:                         &LifecycleRuleFilter{},
+				Status:                         utils.ToPtr(ExpirationStatusEnabled),
+				Expiration:                     &LifecycleExpiration{ExpiredObjectDeleteMarker: utils.ToPtr("true")},
+				AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: utils.ToPtr("7")},
+			},
+		},
+	}
+
+	expected := `<LifecycleConfiguration>` +
+		`<Rule><ID>id1</ID><Filter><Prefix>documents/</Prefix></Filter><Status>Enabled</Status>` +
+		`<Transition><Days>30</Days><StorageClass>GLACIER</StorageClass></Transition></Rule>` +
+		`<Rule><ID>id2</ID><Filter><Prefix>logs/</Prefix></Filter><Status>Enabled</Status>` +
+		`<Expiration><Days>365</Days></Expiration></Rule>` +
+		`<Rule><ID>id3</ID><Filter><And><Prefix>tax/</Prefix>` +
+		`<Tag><Key>key1</Key><Value>value1</Value></Tag><Tag><Key>key2</Key><Value>value2</Value></Tag>` +
+		`<ObjectSizeGreaterThan>500</ObjectSizeGreaterThan></And></Filter><Status>Disabled</Status>` +
+		`<NoncurrentVersionTransition><NoncurrentDays>30</NoncurrentDays><StorageClass>STANDARD_IA</StorageClass></NoncurrentVersionTransition>` +
+		`<NoncurrentVersionExpiration><NoncurrentDays>90</NoncurrentDays><NewerNoncurrentVersions>2</NewerNoncurrentVersions></NoncurrentVersionExpiration></Rule>` +
+		`<Rule><ID>id4</ID><Filter></Filter><Status>Enabled</Status>` +
+		`<Expiration><ExpiredObjectDeleteMarker>true</ExpiredObjectDeleteMarker></Expiration>` +
+		`<AbortIncompleteMultipartUpload><DaysAfterInitiation>7</DaysAfterInitiation></AbortIncompleteMultipartUpload></Rule>` +
+		`</LifecycleConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual LifecycleConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+}
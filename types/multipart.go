@@ -0,0 +1,28 @@
+package types
+
+type CompletedMultipartUpload struct {
+	Parts []CompletedPart `xml:"Part"`
+}
+
+type CompletedPart struct {
+	ChecksumCRC32     *string
+	ChecksumCRC32C    *string
+	ChecksumCRC64NVME *string
+	ChecksumSHA1      *string
+	ChecksumSHA256    *string
+	ETag              *string
+	PartNumber        *string
+}
+
+type CompleteMultipartUploadResult struct {
+	Location          *string
+	Bucket            *string
+	Key               *string
+	ETag              *string
+	ChecksumCRC32     *string
+	ChecksumCRC32C    *string
+	ChecksumCRC64NVME *string
+	ChecksumSHA1      *string
+	ChecksumSHA256    *string
+	ChecksumType      *string
+}
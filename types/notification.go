@@ -0,0 +1,58 @@
+package types
+
+type NotificationConfiguration struct {
+	TopicConfigurations          []TopicConfiguration          `xml:"TopicConfiguration"`
+	QueueConfigurations          []QueueConfiguration          `xml:"QueueConfiguration"`
+	LambdaFunctionConfigurations []LambdaFunctionConfiguration `xml:"CloudFunctionConfiguration"`
+	EventBridgeConfiguration     *EventBridgeConfiguration
+}
+
+type TopicConfiguration struct {
+	Id *string
+
+	// Topic is the ARN of the SNS topic.
+	Topic  *string
+	Events []string `xml:"Event"`
+	Filter *NotificationConfigurationFilter
+}
+
+type QueueConfiguration struct {
+	Id *string
+
+	// Queue is the ARN of the SQS queue.
+	Queue  *string
+	Events []string `xml:"Event"`
+	Filter *NotificationConfigurationFilter
+}
+
+type LambdaFunctionConfiguration struct {
+	Id *string
+
+	// CloudFunction is the ARN of the Lambda function.
+	CloudFunction *string
+	Events        []string `xml:"Event"`
+	Filter        *NotificationConfigurationFilter
+}
+
+// EventBridgeConfiguration enables the delivery of all the events to Amazon EventBridge.
+type EventBridgeConfiguration struct{}
+
+type NotificationConfigurationFilter struct {
+	Key *S3KeyFilter `xml:"S3Key"`
+}
+
+type S3KeyFilter struct {
+	FilterRules []FilterRule `xml:"FilterRule"`
+}
+
+type FilterRule struct {
+	Name  *FilterRuleName
+	Value *string
+}
+
+type FilterRuleName string
+
+const (
+	FilterRuleNamePrefix FilterRuleName = "prefix"
+	FilterRuleNameSuffix FilterRuleName = "suffix"
+)
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationConfiguration(t *testing.T) {
+	configuration := NotificationConfiguration{
+		TopicConfigurations: []TopicConfiguration{
+			{
+				Id:     utils.ToPtr("ObjectCreatedEvents"),
+				Topic:  utils.ToPtr("arn:aws:sns:us-east-1:356671443308:s3notificationtopic2"),
+				Events: []string{"s3:ObjectCreated:*"},
+			},
+		},
+		QueueConfigurations: []QueueConfiguration{
+			{
+				Id:     utils.ToPtr("1"),
+				Queue:  utils.ToPtr("arn:aws:sqs:us-west-2:444455556666:s3notificationqueue"),
+				Events: []string{"s3:ObjectCreated:Put", "s3:ObjectRemoved:*"},
+				Filter: &NotificationConfigurationFilter{
+					Key: &S3KeyFilter{
+						FilterRules: []FilterRule{
+							{Name: utils.ToPtr(FilterRuleNamePrefix), Value: utils.ToPtr("images/")},
+							{Name: utils.ToPtr(FilterRuleNameSuffix), Value: utils.ToPtr(".jpg")},
+						},
+					},
+				},
+			},
+		},
+		LambdaFunctionConfigurations: []LambdaFunctionConfiguration{
+			{
+				Id:            utils.ToPtr("ObjectRemovedEvents"),
+				CloudFunction: utils.ToPtr("arn:aws:lambda:us-west-2:35667example:function:CreateThumbnail"),
+				Events:        []string{"s3:ObjectRemoved:Delete"},
+			},
+		},
+		EventBridgeConfiguration: &EventBridgeConfiguration{},
+	}
+
+	expected := `<NotificationConfiguration>` +
+		`<TopicConfiguration><Id>ObjectCreatedEvents</Id><Topic>arn:aws:sns:us-east-1:356671443308:s3notificationtopic2</Topic>` +
+		`<Event>s3:ObjectCreated:*</Event></TopicConfiguration>` +
+		`<QueueConfiguration><Id>1</Id><Queue>arn:aws:sqs:us-west-2:444455556666:s3notificationqueue</Queue>` +
+		`<Event>s3:ObjectCreated:Put</Event><Event>s3:ObjectRemoved:*</Event>` +
+		`<Filter><S3Key><FilterRule><Name>prefix</Name><Value>images/</Value></FilterRule><FilterRule><Name>suffix</Name><Value>.jpg</Value></FilterRule></S3Key></Filter>` +
+		`</QueueConfiguration>` +
+		`<CloudFunctionConfiguration><Id>ObjectRemovedEvents</Id><CloudFunction>arn:aws:lambda:us-west-2:35667example:function:CreateThumbnail</CloudFunction>` +
+		`<Event>s3:ObjectRemoved:Delete</Event></CloudFunctionConfiguration>` +
+		`<EventBridgeConfiguration></EventBridgeConfiguration>` +
+		`</NotificationConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual NotificationConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+
+	t.Run("unmarshal empty", func(t *testing.T) {
+		// An empty configuration disables the notifications
+		var actual NotificationConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(`<NotificationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`), &actual))
+		require.Equal(t, NotificationConfiguration{}, actual)
+	})
+}
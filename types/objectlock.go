@@ -0,0 +1,52 @@
+package types
+
+import "encoding/xml"
+
+type ObjectLockConfiguration struct {
+	ObjectLockEnabled *ObjectLockEnabled
+	Rule              *ObjectLockRule
+}
+
+type ObjectLockEnabled string
+
+const ObjectLockEnabledEnabled ObjectLockEnabled = "Enabled"
+
+type ObjectLockRule struct {
+	DefaultRetention *DefaultRetention
+}
+
+// DefaultRetention requires Mode and exactly one of Days or Years.
+type DefaultRetention struct {
+	Mode  *ObjectLockRetentionMode
+	Days  *string
+	Years *string
+}
+
+type ObjectLockRetentionMode string
+
+const (
+	ObjectLockRetentionModeGovernance ObjectLockRetentionMode = "GOVERNANCE"
+	ObjectLockRetentionModeCompliance ObjectLockRetentionMode = "COMPLIANCE"
+)
+
+type ObjectLockRetention struct {
+	XMLName xml.Name `xml:"Retention"`
+
+	Mode *ObjectLockRetentionMode
+
+	// RetainUntilDate uses the ISO 8601 format, e.g. 2025-01-01T00:00:00Z.
+	RetainUntilDate *string
+}
+
+type ObjectLockLegalHold struct {
+	XMLName xml.Name `xml:"LegalHold"`
+
+	Status *ObjectLockLegalHoldStatus
+}
+
+type ObjectLockLegalHoldStatus string
+
+const (
+	ObjectLockLegalHoldStatusOn  ObjectLockLegalHoldStatus = "ON"
+	ObjectLockLegalHoldStatusOff ObjectLockLegalHoldStatus = "OFF"
+)
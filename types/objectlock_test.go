@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectLockConfiguration(t *testing.T) {
+	configuration := ObjectLockConfiguration{
+		ObjectLockEnabled: utils.ToPtr(ObjectLockEnabledEnabled),
+		Rule: &ObjectLockRule{
+			DefaultRetention: &DefaultRetention{
+				Mode: utils.ToPtr(ObjectLockRetentionModeCompliance),
+				Days: utils.ToPtr("50"),
+			},
+		},
+	}
+
+	expected := `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>` +
+		`<Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Days>50</Days></DefaultRetention></Rule>` +
+		`</ObjectLockConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual ObjectLockConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+}
+
+func TestObjectLockRetention(t *testing.T) {
+	retention := ObjectLockRetention{
+		XMLName:         xml.Name{Local: "Retention"},
+		Mode:            utils.ToPtr(ObjectLockRetentionModeGovernance),
+		RetainUntilDate: utils.ToPtr("2025-01-01T00:00:00Z"),
+	}
+
+	expected := `<Retention><Mode>GOVERNANCE</Mode><RetainUntilDate>2025-01-01T00:00:00Z</RetainUntilDate></Retention>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&retention)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual ObjectLockRetention
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, retention, actual)
+	})
+}
+
+func TestObjectLockLegalHold(t *testing.T) {
+	legalHold := ObjectLockLegalHold{
+		XMLName: xml.Name{Local: "LegalHold"},
+		Status:  utils.ToPtr(ObjectLockLegalHoldStatusOn),
+	}
+
+	expected := `<LegalHold><Status>ON</Status></LegalHold>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&legalHold)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual ObjectLockLegalHold
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, legalHold, actual)
+	})
+}
@@ -0,0 +1,192 @@
+package types
+
+type BucketLoggingStatus struct {
+	// LoggingEnabled is nil when the server access logging is disabled.
+	LoggingEnabled *LoggingEnabled
+}
+
+type LoggingEnabled struct {
+	TargetBucket          *string
+	TargetPrefix          *string
+	TargetGrants          []TargetGrant `xml:"TargetGrants>Grant"`
+	TargetObjectKeyFormat *TargetObjectKeyFormat
+}
+
+type TargetGrant struct {
+	Grantee    *Grantee
+	Permission *BucketLogsPermission
+}
+
+type BucketLogsPermission string
+
+const (
+	BucketLogsPermissionFullControl BucketLogsPermission = "FULL_CONTROL"
+	BucketLogsPermissionRead        BucketLogsPermission = "READ"
+	BucketLogsPermissionWrite       BucketLogsPermission = "WRITE"
+)
+
+// TargetObjectKeyFormat must contain exactly one of its fields.
+type TargetObjectKeyFormat struct {
+	SimplePrefix      *SimplePrefix
+	PartitionedPrefix *PartitionedPrefix
+}
+
+type SimplePrefix struct{}
+
+type PartitionedPrefix struct {
+	PartitionDateSource *string
+}
+
+type InventoryConfiguration struct {
+	Id                     *string
+	IsEnabled              *string
+	Destination            *InventoryDestination
+	Filter                 *InventoryFilter
+	IncludedObjectVersions *string
+	OptionalFields         []string `xml:"OptionalFields>Field"`
+	Schedule               *InventorySchedule
+}
+
+type InventoryDestination struct {
+	S3BucketDestination *InventoryS3BucketDestination
+}
+
+type InventoryS3BucketDestination struct {
+	AccountId  *string
+	Bucket     *string
+	Format     *string
+	Prefix     *string
+	Encryption *InventoryEncryption
+}
+
+// InventoryEncryption must contain exactly one of its fields.
+type InventoryEncryption struct {
+	SSES3  *SSES3  `xml:"SSE-S3"`
+	SSEKMS *SSEKMS `xml:"SSE-KMS"`
+}
+
+type SSES3 struct{}
+
+type SSEKMS struct {
+	KeyId *string
+}
+
+type InventoryFilter struct {
+	Prefix *string
+}
+
+type InventorySchedule struct {
+	Frequency *string
+}
+
+type ListInventoryConfigurationsResult struct {
+	ContinuationToken       *string
+	NextContinuationToken   *string
+	IsTruncated             *string
+	InventoryConfigurations []InventoryConfiguration `xml:"InventoryConfiguration"`
+}
+
+type MetricsConfiguration struct {
+	Id     *string
+	Filter *MetricsFilter
+}
+
+// MetricsFilter must contain at most one of its fields.
+// Use And to combine several conditions.
+type MetricsFilter struct {
+	Prefix         *string
+	Tag            *Tag
+	AccessPointArn *string
+	And            *MetricsAndOperator
+}
+
+type MetricsAndOperator struct {
+	Prefix         *string
+	Tags           []Tag `xml:"Tag"`
+	AccessPointArn *string
+}
+
+type ListMetricsConfigurationsResult struct {
+	ContinuationToken     *string
+	NextContinuationToken *string
+	IsTruncated           *string
+	MetricsConfigurations []MetricsConfiguration `xml:"MetricsConfiguration"`
+}
+
+type AnalyticsConfiguration struct {
+	Id                   *string
+	Filter               *AnalyticsFilter
+	StorageClassAnalysis *StorageClassAnalysis
+}
+
+// AnalyticsFilter must contain at most one of its fields.
+// Use And to combine several conditions.
+type AnalyticsFilter struct {
+	Prefix *string
+	Tag    *Tag
+	And    *AnalyticsAndOperator
+}
+
+type AnalyticsAndOperator struct {
+	Prefix *string
+	Tags   []Tag `xml:"Tag"`
+}
+
+type StorageClassAnalysis struct {
+	DataExport *StorageClassAnalysisDataExport
+}
+
+type StorageClassAnalysisDataExport struct {
+	OutputSchemaVersion *string
+	Destination         *AnalyticsExportDestination
+}
+
+type AnalyticsExportDestination struct {
+	S3BucketDestination *AnalyticsS3BucketDestination
+}
+
+type AnalyticsS3BucketDestination struct {
+	Format          *string
+	BucketAccountId *string
+	Bucket          *string
+	Prefix          *string
+}
+
+type ListBucketAnalyticsConfigurationResult struct {
+	ContinuationToken       *string
+	NextContinuationToken   *string
+	IsTruncated             *string
+	AnalyticsConfigurations []AnalyticsConfiguration `xml:"AnalyticsConfiguration"`
+}
+
+type IntelligentTieringConfiguration struct {
+	Id       *string
+	Filter   *IntelligentTieringFilter
+	Status   *string
+	Tierings []Tiering `xml:"Tiering"`
+}
+
+// IntelligentTieringFilter must contain at most one of its fields.
+// Use And to combine several conditions.
+type IntelligentTieringFilter struct {
+	Prefix *string
+	Tag    *Tag
+	And    *IntelligentTieringAndOperator
+}
+
+type IntelligentTieringAndOperator struct {
+	Prefix *string
+	Tags   []Tag `xml:"Tag"`
+}
+
+type Tiering struct {
+	Days       *string
+	AccessTier *string
+}
+
+type ListBucketIntelligentTieringConfigurationsOutput struct {
+	ContinuationToken                *string
+	NextContinuationToken            *string
+	IsTruncated                      *string
+	IntelligentTieringConfigurations []IntelligentTieringConfiguration `xml:"IntelligentTieringConfiguration"`
+}
@@ -0,0 +1,238 @@
+package types
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketLoggingStatus(t *testing.T) {
+	status := BucketLoggingStatus{
+		LoggingEnabled: &LoggingEnabled{
+			TargetBucket: utils.ToPtr("mybucketlogs"),
+			TargetPrefix: utils.ToPtr("mybucket-access_log-/"),
+			TargetGrants: []TargetGrant{
+				{
+					Grantee: &Grantee{
+						Type:         GranteeTypeAmazonCustomerByEmail,
+						EmailAddress: utils.ToPtr("user@company.com"),
+					},
+					Permission: utils.ToPtr(BucketLogsPermissionRead),
+				},
+			},
+			TargetObjectKeyFormat: &TargetObjectKeyFormat{
+				PartitionedPrefix: &PartitionedPrefix{PartitionDateSource: utils.ToPtr("EventTime")},
+			},
+		},
+	}
+
+	expected := `<BucketLoggingStatus><LoggingEnabled>` +
+		`<TargetBucket>mybucketlogs</TargetBucket><TargetPrefix>mybucket-access_log-/</TargetPrefix>` +
+		`<TargetGrants><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="AmazonCustomerByEmail"><EmailAddress>user@company.com</EmailAddress></Grantee><Permission>READ</Permission></Grant></TargetGrants>` +
+		`<TargetObjectKeyFormat><PartitionedPrefix><PartitionDateSource>EventTime</PartitionDateSource></PartitionedPrefix></TargetObjectKeyFormat>` +
+		`</LoggingEnabled></BucketLoggingStatus>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&status)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual BucketLoggingStatus
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, status, actual)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		actual, err := xml.Marshal(&BucketLoggingStatus{})
+		require.NoError(t, err)
+		require.Equal(t, `<BucketLoggingStatus></BucketLoggingStatus>`, string(actual))
+	})
+}
+
+func TestInventoryConfiguration(t *testing.T) {
+	configuration := InventoryConfiguration{
+		Id:        utils.ToPtr("report1"),
+		IsEnabled: utils.ToPtr("true"),
+		Destination: &InventoryDestination{
+			S3BucketDestination: &InventoryS3BucketDestination{
+				AccountId: utils.ToPtr("123456789012"),
+				Bucket:    utils.ToPtr("arn:aws:s3:::destination-bucket"),
+				Format:    utils.ToPtr("CSV"),
+				Prefix:    utils.ToPtr("prefix1"),
+				Encryption: &InventoryEncryption{
+					SSEKMS: &SSEKMS{KeyId: utils.ToPtr("arn:aws:kms:us-west-2:111122223333:key/1234abcd")},
+				},
+			},
+		},
+		Filter:                 &InventoryFilter{Prefix: utils.ToPtr("myprefix/")},
+		IncludedObjectVersions: utils.ToPtr("All"),
+		OptionalFields:         []string{"Size", "LastModifiedDate"},
+		Schedule:               &InventorySchedule{Frequency: utils.ToPtr("Daily")},
+	}
+
+	expected := `<InventoryConfiguration><Id>report1</Id><IsEnabled>true</IsEnabled>` +
+		`<Destination><S3BucketDestination><AccountId>123456789012</AccountId><Bucket>arn:aws:s3:::destination-bucket</Bucket>` +
+		`<Format>CSV</Format><Prefix>prefix1</Prefix>` +
+		`<Encryption><SSE-KMS><KeyId>arn:aws:kms:us-west-2:111122223333:key/1234abcd</KeyId></SSE-KMS></Encryption>` +
+		`</S3BucketDestination></Destination>` +
+		`<Filter><Prefix>myprefix/</Prefix></Filter><IncludedObjectVersions>All</IncludedObjectVersions>` +
+		`<OptionalFields><Field>Size</Field><Field>LastModifiedDate</Field></OptionalFields>` +
+		`<Schedule><Frequency>Daily</Frequency></Schedule></InventoryConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual InventoryConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+}
+
+func TestMetricsConfiguration(t *testing.T) {
+	configuration := MetricsConfiguration{
+		Id: utils.ToPtr("EntireBucket"),
+		Filter: &MetricsFilter{
+			And: &MetricsAndOperator{
+				Prefix: utils.ToPtr("documents/"),
+				Tags: []Tag{
+					{Key: utils.ToPtr("priority"), Value: utils.ToPtr("high")},
+					{Key: utils.ToPtr("class"), Value: utils.ToPtr("blue")},
+				},
+			},
+		},
+	}
+
+	expected := `<MetricsConfiguration><Id>EntireBucket</Id><Filter><And><Prefix>documents/</Prefix>` +
+		`<Tag><Key>priority</Key><Value>high</Value></Tag><Tag><Key>class</Key><Value>blue</Value></Tag>` +
+		`</And></Filter></MetricsConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual MetricsConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+}
+
+func TestAnalyticsConfiguration(t *testing.T) {
+	configuration := AnalyticsConfiguration{
+		Id:     utils.ToPtr("report1"),
+		Filter: &AnalyticsFilter{Tag: &Tag{Key: utils.ToPtr("dept"), Value: utils.ToPtr("finance")}},
+		StorageClassAnalysis: &StorageClassAnalysis{
+			DataExport: &StorageClassAnalysisDataExport{
+				OutputSchemaVersion: utils.ToPtr("V_1"),
+				Destination: &AnalyticsExportDestination{
+					S3BucketDestination: &AnalyticsS3BucketDestination{
+						Format:          utils.ToPtr("CSV"),
+						BucketAccountId: utils.ToPtr("123456789012"),
+						Bucket:          utils.ToPtr("arn:aws:s3:::destination-bucket"),
+						Prefix:          utils.ToPtr("destination-prefix"),
+					},
+				},
+			},
+		},
+	}
+
+	expected := `<AnalyticsConfiguration><Id>report1</Id>` +
+		`<Filter><Tag><Key>dept</Key><Value>finance</Value></Tag></Filter>` +
+		`<StorageClassAnalysis><DataExport><OutputSchemaVersion>V_1</OutputSchemaVersion>` +
+		`<Destination><S3BucketDestination><Format>CSV</Format><BucketAccountId>123456789012</BucketAccountId>` +
+		`<Bucket>arn:aws:s3:::destination-bucket</Bucket><Prefix>destination-prefix</Prefix></S3BucketDestination></Destination>` +
+		`</DataExport></StorageClassAnalysis></AnalyticsConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual AnalyticsConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+}
+
+func TestIntelligentTieringConfiguration(t *testing.T) {
+	configuration := IntelligentTieringConfiguration{
+		Id:     utils.ToPtr("ExampleConfig"),
+		Filter: &IntelligentTieringFilter{Prefix: utils.ToPtr("images")},
+		Status: utils.ToPtr("Enabled"),
+		Tierings: []Tiering{
+			{Days: utils.ToPtr("90"), AccessTier: utils.ToPtr("ARCHIVE_ACCESS")},
+			{Days: utils.ToPtr("180"), AccessTier: utils.ToPtr("DEEP_ARCHIVE_ACCESS")},
+		},
+	}
+
+	expected := `<IntelligentTieringConfiguration><Id>ExampleConfig</Id>` +
+		`<Filter><Prefix>images</Prefix></Filter><Status>Enabled</Status>` +
+		`<Tiering><Days>90</Days><AccessTier>ARCHIVE_ACCESS</AccessTier></Tiering>` +
+		`<Tiering><Days>180</Days><AccessTier>DEEP_ARCHIVE_ACCESS</AccessTier></Tiering>` +
+		`</IntelligentTieringConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual IntelligentTieringConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+}
+
+func TestListInventoryConfigurationsResult(t *testing.T) {
+	// Sample from the ListBucketInventoryConfigurations documentation
+	sample := `<?xml version="1.0" encoding="UTF-8"?>
+<ListInventoryConfigurationsResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<InventoryConfiguration>
+		<Id>report1</Id>
+		<IsEnabled>true</IsEnabled>
+		<Schedule>
+			<Frequency>Daily</Frequency>
+		</Schedule>
+	</InventoryConfiguration>
+	<InventoryConfiguration>
+		<Id>report2</Id>
+		<IsEnabled>false</IsEnabled>
+	</InventoryConfiguration>
+	<IsTruncated>true</IsTruncated>
+	<ContinuationToken>token1</ContinuationToken>
+	<NextContinuationToken>token2</NextContinuationToken>
+</ListInventoryConfigurationsResult>`
+
+	var actual ListInventoryConfigurationsResult
+	require.NoError(t, xml.Unmarshal([]byte(sample), &actual))
+	require.Equal(t, ListInventoryConfigurationsResult{
+		ContinuationToken:     utils.ToPtr("token1"),
+		NextContinuationToken: utils.ToPtr("token2"),
+		IsTruncated:           utils.ToPtr("true"),
+		InventoryConfigurations: []InventoryConfiguration{
+			{
+				Id:        utils.ToPtr("report1"),
+				IsEnabled: utils.ToPtr("true"),
+				Schedule:  &InventorySchedule{Frequency: utils.ToPtr("Daily")},
+			},
+			{
+				Id:        utils.ToPtr("report2"),
+				IsEnabled: utils.ToPtr("false"),
+			},
+		},
+	}, actual)
+}
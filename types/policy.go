@@ -0,0 +1,12 @@
+package types
+
+type PolicyStatus struct {
+	IsPublic *string
+}
+
+type PublicAccessBlockConfiguration struct {
+	BlockPublicAcls       *string
+	IgnorePublicAcls      *string
+	BlockPublicPolicy     *string
+	RestrictPublicBuckets *string
+}
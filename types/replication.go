@@ -0,0 +1,95 @@
+package types
+
+type ReplicationConfiguration struct {
+	Role  *string
+	Rules []ReplicationRule `xml:"Rule"`
+}
+
+type ReplicationRule struct {
+	ID       *string
+	Priority *string
+	Status   *ReplicationRuleStatus
+	Filter   *ReplicationRuleFilter
+
+	// Prefix is deprecated, use Filter instead.
+	Prefix *string
+
+	SourceSelectionCriteria   *SourceSelectionCriteria
+	ExistingObjectReplication *ExistingObjectReplication
+	Destination               *Destination
+	DeleteMarkerReplication   *DeleteMarkerReplication
+}
+
+type ReplicationRuleStatus string
+
+const (
+	ReplicationRuleStatusEnabled  ReplicationRuleStatus = "Enabled"
+	ReplicationRuleStatusDisabled ReplicationRuleStatus = "Disabled"
+)
+
+// ReplicationRuleFilter must contain at most one of its fields.
+// Use And to combine several conditions.
+type ReplicationRuleFilter struct {
+	Prefix *string
+	Tag    *Tag
+	And    *ReplicationRuleAndOperator
+}
+
+type ReplicationRuleAndOperator struct {
+	Prefix *string
+	Tags   []Tag `xml:"Tag"`
+}
+
+type SourceSelectionCriteria struct {
+	ReplicaModifications   *ReplicaModifications
+	SseKmsEncryptedObjects *SseKmsEncryptedObjects
+}
+
+type ReplicaModifications struct {
+	Status *ReplicationRuleStatus
+}
+
+type SseKmsEncryptedObjects struct {
+	Status *ReplicationRuleStatus
+}
+
+type ExistingObjectReplication struct {
+	Status *ReplicationRuleStatus
+}
+
+type DeleteMarkerReplication struct {
+	Status *ReplicationRuleStatus
+}
+
+type Destination struct {
+	// Bucket is the ARN of the destination bucket.
+	Bucket                   *string
+	Account                  *string
+	StorageClass             *string
+	AccessControlTranslation *AccessControlTranslation
+	EncryptionConfiguration  *EncryptionConfiguration
+	ReplicationTime          *ReplicationTime
+	Metrics                  *ReplicationMetrics
+}
+
+type AccessControlTranslation struct {
+	Owner *string
+}
+
+type EncryptionConfiguration struct {
+	ReplicaKmsKeyID *string
+}
+
+type ReplicationTime struct {
+	Status *ReplicationRuleStatus
+	Time   *ReplicationTimeValue
+}
+
+type ReplicationMetrics struct {
+	Status         *ReplicationRuleStatus
+	EventThreshold *ReplicationTimeValue
+}
+
+type ReplicationTimeValue struct {
+	Minutes *string
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationConfiguration(t *testing.T) {
+	configuration := ReplicationConfiguration{
+		Role: utils.ToPtr("arn:aws:iam::35667example:role/CrossRegionReplicationRoleForS3"),
+		Rules: []ReplicationRule{
+			{
+				ID:       utils.ToPtr("rule1"),
+				Priority: utils.ToPtr("1"),
+				Status:   utils.ToPtr(ReplicationRuleStatusEnabled),
+				Filter: &ReplicationRuleFilter{
+					And: &ReplicationRuleAndOperator{
+						Prefix: utils.ToPtr("TaxDocs"),
+						Tags:   []Tag{{Key: utils.ToPtr("key1"), Value: utils.ToPtr("value1")}},
+					},
+				},
+				SourceSelectionCriteria: &SourceSelectionCriteria{
+					SseKmsEncryptedObjects: &SseKmsEncryptedObjects{Status: utils.ToPtr(ReplicationRuleStatusEnabled)},
+				},
+				Destination: &Destination{
+					Bucket:       utils.ToPtr("arn:aws:s3:::exampletargetbucket"),
+					Account:      utils.ToPtr("123456789012"),
+					StorageClass: utils.ToPtr("STANDARD"),
+					AccessControlTranslation: &AccessControlTranslation{
+						Owner: utils.ToPtr("Destination"),
+					},
+					EncryptionConfiguration: &EncryptionConfiguration{
+						ReplicaKmsKeyID: utils.ToPtr("arn:aws:kms:us-east-1:123456789012:key/example"),
+					},
+					ReplicationTime: &ReplicationTime{
+						Status: utils.ToPtr(ReplicationRuleStatusEnabled),
+						Time:   &ReplicationTimeValue{Minutes: utils.ToPtr("15")},
+					},
+					Metrics: &ReplicationMetrics{
+						Status:         utils.ToPtr(ReplicationRuleStatusEnabled),
+						EventThreshold: &ReplicationTimeValue{Minutes: utils.ToPtr("15")},
+					},
+				},
+				DeleteMarkerReplication: &DeleteMarkerReplication{Status: utils.ToPtr(ReplicationRuleStatusDisabled)},
+			},
+		},
+	}
+
+	expected := `<ReplicationConfiguration>` +
+		`<Role>arn:aws:iam::35667example:role/CrossRegionReplicationRoleForS3</Role>` +
+		`<Rule><ID>rule1</ID><Priority>1</Priority><Status>Enabled</Status>` +
+		`<Filter><And><Prefix>TaxDocs</Prefix><Tag><Key>key1</Key><Value>value1</Value></Tag></And></Filter>` +
+		`<SourceSelectionCriteria><SseKmsEncryptedObjects><Status>Enabled</Status></SseKmsEncryptedObjects></SourceSelectionCriteria>` +
+		`<Destination><Bucket>arn:aws:s3:::exampletargetbucket</Bucket><Account>123456789012</Account><StorageClass>STANDARD</StorageClass>` +
+		`<AccessControlTranslation><Owner>Destination</Owner></AccessControlTranslation>` +
+		`<EncryptionConfiguration><ReplicaKmsKeyID>arn:aws:kms:us-east-1:123456789012:key/example</ReplicaKmsKeyID></EncryptionConfiguration>` +
+		`<ReplicationTime><Status>Enabled</Status><Time><Minutes>15</Minutes></Time></ReplicationTime>` +
+		`<Metrics><Status>Enabled</Status><EventThreshold><Minutes>15</Minutes></EventThreshold></Metrics>` +
+		`</Destination>` +
+		`<DeleteMarkerReplication><Status>Disabled</Status></DeleteMarkerReplication></Rule>` +
+		`</ReplicationConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual ReplicationConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+}
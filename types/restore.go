@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RestoreRequest struct {
+	// Days is the lifetime of the restored copy, not allowed for buckets with versioning suspended.
+	Days                 *string
+	Description          *string
+	GlacierJobParameters *GlacierJobParameters
+}
+
+type GlacierJobParameters struct {
+	Tier *Tier
+}
+
+type Tier string
+
+const (
+	TierStandard  Tier = "Standard"
+	TierBulk      Tier = "Bulk"
+	TierExpedited Tier = "Expedited"
+)
+
+// Restore is the parsed value of the x-amz-restore header.
+type Restore struct {
+	// OngoingRequest is true while the restoration is in progress.
+	OngoingRequest bool
+
+	// ExpiryDate is set once the object is restored.
+	ExpiryDate *time.Time
+}
+
+// ParseRestore parses the x-amz-restore header, for example:
+//
+//	ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"
+func ParseRestore(value string) (*Restore, error) {
+	ret := new(Restore)
+
+	for remaining := strings.TrimSpace(value); remaining != ""; {
+		key, rest, found := strings.Cut(remaining, "=")
+		if !found {
+			return nil, fmt.Errorf("x-amz-restore: missing value for %q", key)
+		}
+		key = strings.TrimSpace(key)
+
+		if !strings.HasPrefix(rest, `"`) {
+			return nil, fmt.Errorf("x-amz-restore: unquoted value for %q", key)
+		}
+
+		fieldValue, rest, found := strings.Cut(rest[1:], `"`)
+		if !found {
+			return nil, fmt.Errorf("x-amz-restore: unterminated value for %q", key)
+		}
+
+		switch key {
+		case "ongoing-request":
+			ongoing, err := strconv.ParseBool(fieldValue)
+			if err != nil {
+				return nil, fmt.Errorf("x-amz-restore: invalid ongoing-request: %w", err)
+			}
+			ret.OngoingRequest = ongoing
+
+		case "expiry-date":
+			expiryDate, err := time.Parse(time.RFC1123, fieldValue)
+			if err != nil {
+				return nil, fmt.Errorf("x-amz-restore: invalid expiry-date: %w", err)
+			}
+			ret.ExpiryDate = &expiryDate
+
+		default:
+			// Unknown fields are ignored for forward compatibility
+		}
+
+		remaining = strings.TrimLeft(rest, ", ")
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRestore(t *testing.T) {
+	expiryDate := time.Date(2012, time.December, 21, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected *Restore
+	}{
+		{
+			name:     "ongoing",
+			value:    `ongoing-request="true"`,
+			expected: &Restore{OngoingRequest: true},
+		},
+		{
+			name:     "restored",
+			value:    `ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`,
+			expected: &Restore{OngoingRequest: false, ExpiryDate: &expiryDate},
+		},
+		{
+			name:     "unknown field",
+			value:    `ongoing-request="false", restore-type="TEMPORARY"`,
+			expected: &Restore{OngoingRequest: false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ParseRestore(tc.value)
+			require.NoError(t, err)
+
+			if tc.expected.ExpiryDate != nil {
+				require.NotNil(t, actual.ExpiryDate)
+				require.True(t, tc.expected.ExpiryDate.Equal(*actual.ExpiryDate))
+				actual.ExpiryDate = tc.expected.ExpiryDate
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, value := range []string{
+			`ongoing-request`,
+			`ongoing-request=false`,
+			`ongoing-request="false`,
+			`ongoing-request="maybe"`,
+			`expiry-date="tomorrow"`,
+		} {
+			_, err := ParseRestore(value)
+			require.Error(t, err, value)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package types
+
+type SelectObjectContentRequest struct {
+	Expression          *string
+	ExpressionType      *ExpressionType
+	RequestProgress     *RequestProgress
+	InputSerialization  *InputSerialization
+	OutputSerialization *OutputSerialization
+	ScanRange           *ScanRange
+}
+
+type ExpressionType string
+
+const ExpressionTypeSQL ExpressionType = "SQL"
+
+type RequestProgress struct {
+	Enabled *string
+}
+
+// InputSerialization must contain exactly one of CSV, JSON or Parquet.
+type InputSerialization struct {
+	CompressionType *string
+	CSV             *CSVInput
+	JSON            *JSONInput
+	Parquet         *ParquetInput
+}
+
+type CSVInput struct {
+	AllowQuotedRecordDelimiter *string
+	Comments                   *string
+	FieldDelimiter             *string
+	FileHeaderInfo             *string
+	QuoteCharacter             *string
+	QuoteEscapeCharacter       *string
+	RecordDelimiter            *string
+}
+
+type JSONInput struct {
+	Type *string
+}
+
+type ParquetInput struct{}
+
+// OutputSerialization must contain exactly one of CSV or JSON.
+type OutputSerialization struct {
+	CSV  *CSVOutput
+	JSON *JSONOutput
+}
+
+type CSVOutput struct {
+	FieldDelimiter       *string
+	QuoteCharacter       *string
+	QuoteEscapeCharacter *string
+	QuoteFields          *string
+	RecordDelimiter      *string
+}
+
+type JSONOutput struct {
+	RecordDelimiter *string
+}
+
+type ScanRange struct {
+	Start *string
+	End   *string
+}
+
+// SelectObjectContentEvent is one of [RecordsEvent], [StatsEvent],
+// [ProgressEvent], [ContinuationEvent] or [EndEvent].
+type SelectObjectContentEvent interface {
+	isSelectObjectContentEvent()
+}
+
+type RecordsEvent struct {
+	Payload []byte
+}
+
+type StatsEvent struct {
+	Details *Stats
+}
+
+type ProgressEvent struct {
+	Details *Progress
+}
+
+// ContinuationEvent is a keep-alive message.
+type ContinuationEvent struct{}
+
+// EndEvent is the last event of a successful request.
+type EndEvent struct{}
+
+func (*RecordsEvent) isSelectObjectContentEvent()      {}
+func (*StatsEvent) isSelectObjectContentEvent()        {}
+func (*ProgressEvent) isSelectObjectContentEvent()     {}
+func (*ContinuationEvent) isSelectObjectContentEvent() {}
+func (*EndEvent) isSelectObjectContentEvent()          {}
+
+type Stats struct {
+	BytesScanned   *string
+	BytesProcessed *string
+	BytesReturned  *string
+}
+
+type Progress struct {
+	BytesScanned   *string
+	BytesProcessed *string
+	BytesReturned  *string
+}
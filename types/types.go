@@ -23,3 +23,8 @@ type CreateBucketConfiguration struct {
 }
 
 type LocationConstraint string
+
+type Tag struct {
+	Key   *string
+	Value *string
+}
@@ -0,0 +1,68 @@
+package types
+
+type VersioningConfiguration struct {
+	Status    *BucketVersioningStatus
+	MFADelete *MFADelete `xml:"MfaDelete"`
+}
+
+type BucketVersioningStatus string
+
+const (
+	BucketVersioningStatusEnabled   BucketVersioningStatus = "Enabled"
+	BucketVersioningStatusSuspended BucketVersioningStatus = "Suspended"
+)
+
+type MFADelete string
+
+const (
+	MFADeleteEnabled  MFADelete = "Enabled"
+	MFADeleteDisabled MFADelete = "Disabled"
+)
+
+type ListVersionsResult struct {
+	Name                *string
+	Prefix              *string
+	Delimiter           *string
+	EncodingType        *string
+	KeyMarker           *string
+	VersionIdMarker     *string
+	NextKeyMarker       *string
+	NextVersionIdMarker *string
+	MaxKeys             *string
+	IsTruncated         *string
+
+	Versions       []ObjectVersion     `xml:"Version"`
+	DeleteMarkers  []DeleteMarkerEntry `xml:"DeleteMarker"`
+	CommonPrefixes []CommonPrefix
+}
+
+type ObjectVersion struct {
+	ChecksumAlgorithm []string
+	ChecksumType      *string
+	ETag              *string
+	IsLatest          *string
+	Key               *string
+	LastModified      *string
+	Owner             *Owner
+	RestoreStatus     *RestoreStatus
+	Size              *string
+	StorageClass      *string
+	VersionId         *string
+}
+
+type DeleteMarkerEntry struct {
+	IsLatest     *string
+	Key          *string
+	LastModified *string
+	Owner        *Owner
+	VersionId    *string
+}
+
+type CommonPrefix struct {
+	Prefix *string
+}
+
+type RestoreStatus struct {
+	IsRestoreInProgress *string
+	RestoreExpiryDate   *string
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/s3hobby/client/pkg/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersioningConfiguration(t *testing.T) {
+	configuration := VersioningConfiguration{
+		Status:    utils.ToPtr(BucketVersioningStatusEnabled),
+		MFADelete: utils.ToPtr(MFADeleteDisabled),
+	}
+
+	expected := `<VersioningConfiguration><Status>Enabled</Status><MfaDelete>Disabled</MfaDelete></VersioningConfiguration>`
+
+	t.Run("marshal", func(t *testing.T) {
+		actual, err := xml.Marshal(&configuration)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(actual))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual VersioningConfiguration
+		require.NoError(t, xml.Unmarshal([]byte(expected), &actual))
+		require.Equal(t, configuration, actual)
+	})
+}
+
+func TestListVersionsResult(t *testing.T) {
+	owner := &Owner{
+		ID:          utils.ToPtr("75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a"),
+		DisplayName: utils.ToPtr("mtd@amazon.com"),
+	}
+
+	result := ListVersionsResult{
+		Name:            utils.ToPtr("bucket"),
+		Prefix:          utils.ToPtr("my"),
+		KeyMarker:       utils.ToPtr(""),
+		VersionIdMarker: utils.ToPtr(""),
+		MaxKeys:         utils.ToPtr("5"),
+		IsTruncated:     utils.ToPtr("false"),
+		Versions: []ObjectVersion{
+			{
+				ETag:         utils.ToPtr(`"fba9dede5f27731c9771645a39863328"`),
+				IsLatest:     utils.ToPtr("true"),
+				Key:          utils.ToPtr("my-image.jpg"),
+				LastModified: utils.ToPtr("2009-10-12T17:50:30.000Z"),
+				Owner:        owner,
+				Size:         utils.ToPtr("434234"),
+				StorageClass: utils.ToPtr("STANDARD"),
+				VersionId:    utils.ToPtr("3/L4kqtJl40Nr8X8gdRQBpUMLUo"),
+			},
+		},
+		DeleteMarkers: []DeleteMarkerEntry{
+			{
+				IsLatest:     utils.ToPtr("true"),
+				Key:          utils.ToPtr("my-second-image.jpg"),
+				LastModified: utils.ToPtr("2009-11-12T17:50:30.000Z"),
+				Owner:        owner,
+				VersionId:    utils.ToPtr("03jpff543dhffds434rfdsFDN943fdsFkdmqnh892"),
+			},
+		},
+		CommonPrefixes: []CommonPrefix{
+			{Prefix: utils.ToPtr("photos/")},
+		},
+	}
+
+	// Sample from the ListObjectVersions documentation
+	sample := `<?xml version="1.0" encoding="UTF-8"?>
+<ListVersionsResult xmlns="http://s3.amazonaws.com/doc/2006-03-01">
+	<Name>bucket</Name>
+	<Prefix>my</Prefix>
+	<KeyMarker/>
+	<VersionIdMarker/>
+	<MaxKeys>5</MaxKeys>
+	<IsTruncated>false</IsTruncated>
+	<Version>
+		<Key>my-image.jpg</Key>
+		<VersionId>3/L4kqtJl40Nr8X8gdRQBpUMLUo</VersionId>
+		<IsLatest>true</IsLatest>
+		<LastModified>2009-10-12T17:50:30.000Z</LastModified>
+		<ETag>"fba9dede5f27731c9771645a39863328"</ETag>
+		<Size>434234</Size>
+		<StorageClass>STANDARD</StorageClass>
+		<Owner>
+			<ID>75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a</ID>
+			<DisplayName>mtd@amazon.com</DisplayName>
+		</Owner>
+	</Version>
+	<DeleteMarker>
+		<Key>my-second-image.jpg</Key>
+		<VersionId>03jpff543dhffds434rfdsFDN943fdsFkdmqnh892</VersionId>
+		<IsLatest>true</IsLatest>
+		<LastModified>2009-11-12T17:50:30.000Z</LastModified>
+		<Owner>
+			<ID>75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a</ID>
+			<DisplayName>mtd@amazon.com</DisplayName>
+		</Owner>
+	</DeleteMarker>
+	<CommonPrefixes>
+		<Prefix>photos/</Prefix>
+	</CommonPrefixes>
+</ListVersionsResult>`
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var actual ListVersionsResult
+		require.NoError(t, xml.Unmarshal([]byte(sample), &actual))
+		require.Equal(t, result, actual)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		b, err := xml.Marshal(&result)
+		require.NoError(t, err)
+
+		var actual ListVersionsResult
+		require.NoError(t, xml.Unmarshal(b, &actual))
+		require.Equal(t, result, actual)
+	})
+}
@@ -0,0 +1,49 @@
+package types
+
+// WebsiteConfiguration either redirects all the requests with RedirectAllRequestsTo
+// or serves the bucket content with IndexDocument, ErrorDocument and RoutingRules.
+type WebsiteConfiguration struct {
+	ErrorDocument         *ErrorDocument
+	IndexDocument         *IndexDocument
+	RedirectAllRequestsTo *RedirectAllRequestsTo
+	RoutingRules          []RoutingRule `xml:"RoutingRules>RoutingRule"`
+}
+
+type ErrorDocument struct {
+	Key *string
+}
+
+type IndexDocument struct {
+	Suffix *string
+}
+
+type RedirectAllRequestsTo struct {
+	HostName *string
+	Protocol *Protocol
+}
+
+type Protocol string
+
+const (
+	ProtocolHTTP  Protocol = "http"
+	ProtocolHTTPS Protocol = "https"
+)
+
+type RoutingRule struct {
+	Condition *RoutingRuleCondition
+	Redirect  *RoutingRuleRedirect
+}
+
+type RoutingRuleCondition struct {
+	HttpErrorCodeReturnedEquals *string
+	KeyPrefixEquals             *string
+}
+
+// RoutingRuleRedirect cannot have both ReplaceKeyPrefixWith and ReplaceKeyWith.
+type RoutingRuleRedirect struct {
+	HostName             *string
+	HttpRedirectCode     *string
+	Protocol             *Protocol
+	ReplaceKeyPrefixWith *string
+	ReplaceKeyWith       *string
+}
@@ -1,7 +1,11 @@
 package client
 
 import (
+	"crypto/md5" //nolint:gosec // Content-MD5 is part of the S3 protocol
+	"encoding/base64"
+	"encoding/xml"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/valyala/fasthttp"
@@ -33,11 +37,13 @@ func setHeaderOrTrailer(requestHeader *fasthttp.RequestHeader, key string, heade
 	case haveValue && !haveTrailer:
 		requestHeader.Set(key, *header)
 	case !haveValue && haveTrailer:
+		// Several trailers are listed separated by commas
 		if actual := requestHeader.Peek(HeaderXAmzTrailer); len(actual) > 0 {
-			panic(fmt.Sprintf("trailer already set: %q", actual))
+			requestHeader.Set(HeaderXAmzTrailer, string(actual)+","+key)
+		} else {
+			requestHeader.Set(HeaderXAmzTrailer, key)
 		}
 
-		requestHeader.Set(HeaderXAmzTrailer, key)
 		requestHeader.Set(key, *trailer)
 	default:
 		// !haveValue && !haveTrailer is a no-op
@@ -53,3 +59,45 @@ func extractHeader(responseHeader *fasthttp.ResponseHeader, key string) *string
 	str := string(value)
 	return &str
 }
+
+func setXMLBody(req *fasthttp.Request, payload any) error {
+	body, err := xml.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req.SetBody(body)
+	return nil
+}
+
+// setContentMD5 sets the Content-MD5 header to value when provided,
+// otherwise computes it from the request body: the ContentMD5 fields of the
+// inputs using it can be left nil.
+// Some operations are rejected by S3 when the payload integrity is not provided.
+func setContentMD5(req *fasthttp.Request, value *string) {
+	if value != nil {
+		req.Header.Set(HeaderContentMD5, *value)
+		return
+	}
+
+	sum := md5.Sum(req.Body()) //nolint:gosec // Content-MD5 is part of the S3 protocol
+	req.Header.Set(HeaderContentMD5, base64.StdEncoding.EncodeToString(sum[:]))
+}
+
+// expectStatus returns a server-side error unless the response status is one of statusCodes.
+// AWS S3 answers some operations with 204 while some S3-compatible servers answer with 200.
+func expectStatus(resp *fasthttp.Response, statusCodes ...int) error {
+	if slices.Contains(statusCodes, resp.StatusCode()) {
+		return nil
+	}
+
+	return NewServerSideError(resp)
+}
+
+func unmarshalXMLBody(resp *fasthttp.Response, operation string, payload any) error {
+	if err := xml.Unmarshal(resp.Body(), payload); err != nil {
+		return fmt.Errorf("%s: cannot parse response body: %w", operation, err)
+	}
+
+	return nil
+}